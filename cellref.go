@@ -0,0 +1,24 @@
+package goxcel
+
+import "fmt"
+
+// columnLetter converts a 0-based column index into its spreadsheet letter
+// (0 -> "A", 25 -> "Z", 26 -> "AA", ...).
+func columnLetter(idx int) string {
+	idx++ // switch to 1-based for the standard base-26 bijective conversion
+	var letters []byte
+	for idx > 0 {
+		idx--
+		letters = append([]byte{byte('A' + idx%26)}, letters...)
+		idx /= 26
+	}
+	return string(letters)
+}
+
+// a1Ref builds an A1-style cell reference for a data row. dataRowIdx is the
+// 1-based data row index (1 = the first row after the header), so the
+// spreadsheet row number is dataRowIdx+1 once the header row is accounted
+// for.
+func a1Ref(colIdx, dataRowIdx int) string {
+	return fmt.Sprintf("%s%d", columnLetter(colIdx), dataRowIdx+1)
+}
@@ -0,0 +1,256 @@
+package goxcel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ColumnRule is a per-cell value constraint attached to a column via
+// SchemaBuilder (ColumnRegex, ColumnRange, ColumnEnum, ColumnUnique,
+// ColumnNotBlank, ColumnRefIn). Violations surface as ValidationError
+// entries of type ErrorCellValue.
+type ColumnRule interface {
+	// ruleID is a short stable name for the rule kind (e.g. "regex",
+	// "ref-in"). ValidationError does not carry it; an ErrorCellValue
+	// error names its rule only through its Expected text.
+	ruleID() string
+	// describe renders the rule for use in an error's Expected field.
+	describe() string
+}
+
+type regexRule struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func (regexRule) ruleID() string     { return "regex" }
+func (r regexRule) describe() string { return fmt.Sprintf("matches /%s/", r.pattern) }
+
+type rangeRule struct {
+	min, max float64
+}
+
+func (rangeRule) ruleID() string     { return "range" }
+func (r rangeRule) describe() string { return fmt.Sprintf("between %g and %g", r.min, r.max) }
+
+type enumRule struct {
+	values []string
+}
+
+func (enumRule) ruleID() string     { return "enum" }
+func (r enumRule) describe() string { return fmt.Sprintf("one of %v", r.values) }
+
+type uniqueRule struct{}
+
+func (uniqueRule) ruleID() string   { return "unique" }
+func (uniqueRule) describe() string { return "unique within column" }
+
+type notBlankRule struct{}
+
+func (notBlankRule) ruleID() string   { return "not-blank" }
+func (notBlankRule) describe() string { return "not blank" }
+
+type refInRule struct {
+	otherColumn string
+}
+
+func (refInRule) ruleID() string     { return "ref-in" }
+func (r refInRule) describe() string { return fmt.Sprintf("value of column %q", r.otherColumn) }
+
+// ColumnRegex requires every non-empty value in col to match pattern.
+// Panics if pattern doesn't compile, since an invalid pattern is a
+// programming error in the schema definition, not a runtime condition.
+// Callers that source pattern from outside the program (e.g. loader.go's
+// template files) should use newRegexRule directly instead, so a bad
+// pattern becomes a returned error rather than a panic.
+func (b *SchemaBuilder) ColumnRegex(col, pattern string) *SchemaBuilder {
+	rule, err := newRegexRule(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("goxcel: invalid regex for column %q: %v", col, err))
+	}
+	return b.addRule(col, rule)
+}
+
+// newRegexRule compiles pattern into a regexRule, returning an error
+// instead of panicking when it doesn't compile.
+func newRegexRule(pattern string) (regexRule, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return regexRule{}, err
+	}
+	return regexRule{pattern: pattern, re: re}, nil
+}
+
+// ColumnRange requires every numeric value in col to fall within [min, max].
+// Non-numeric values fail the rule.
+func (b *SchemaBuilder) ColumnRange(col string, min, max float64) *SchemaBuilder {
+	return b.addRule(col, rangeRule{min: min, max: max})
+}
+
+// ColumnEnum requires every non-empty value in col to be one of values.
+func (b *SchemaBuilder) ColumnEnum(col string, values ...string) *SchemaBuilder {
+	return b.addRule(col, enumRule{values: values})
+}
+
+// ColumnUnique requires every non-empty value in col to appear at most once
+// across the table's data rows.
+func (b *SchemaBuilder) ColumnUnique(col string) *SchemaBuilder {
+	return b.addRule(col, uniqueRule{})
+}
+
+// ColumnNotBlank requires every data row to have a non-empty value in col.
+func (b *SchemaBuilder) ColumnNotBlank(col string) *SchemaBuilder {
+	return b.addRule(col, notBlankRule{})
+}
+
+// ColumnRefIn requires every non-empty value in col to also appear
+// somewhere in otherCol, a cross-column referential check (e.g. an
+// "OrderID" column whose values must exist in a sibling "ID" column).
+func (b *SchemaBuilder) ColumnRefIn(col, otherCol string) *SchemaBuilder {
+	return b.addRule(col, refInRule{otherColumn: otherCol})
+}
+
+// MaxCellErrors caps the number of ErrorCellValue errors collected per
+// sheet before value-rule evaluation stops early, bounding validation cost
+// on very large or very dirty workbooks.
+func (b *SchemaBuilder) MaxCellErrors(n int) *SchemaBuilder {
+	b.schema.MaxCellErrorsN = n
+	return b
+}
+
+func (b *SchemaBuilder) addRule(col string, rule ColumnRule) *SchemaBuilder {
+	if b.schema.ValueRules == nil {
+		b.schema.ValueRules = make(map[string][]ColumnRule)
+	}
+	b.schema.ValueRules[col] = append(b.schema.ValueRules[col], rule)
+	return b
+}
+
+// evaluateValueRules checks every ColumnRule declared in rules against the
+// data rows of table, returning one ValidationError per violation (capped
+// at maxErrors when maxErrors > 0).
+func evaluateValueRules(sheetName, tableName string, table *Table, rules map[string][]ColumnRule, maxErrors int) []ValidationError {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	colIndex := make(map[string]int, len(table.Headers))
+	for i, h := range table.Headers {
+		colIndex[h] = i
+	}
+
+	var errs []ValidationError
+	seen := make(map[string]map[string]int) // col -> value -> first row seen
+
+	emit := func(col string, rowIdx int, value, descriptor string) bool {
+		errs = append(errs, ValidationError{
+			Type:     ErrorCellValue,
+			Message:  fmt.Sprintf("row %d: column %q value %q violates rule: %s", rowIdx, col, value, descriptor),
+			Sheet:    sheetName,
+			Table:    tableName,
+			Column:   col,
+			Row:      rowIdx,
+			Ref:      a1Ref(colIndex[col], rowIdx),
+			Expected: descriptor,
+			Actual:   value,
+		})
+		return maxErrors <= 0 || len(errs) < maxErrors
+	}
+
+	cols := sortedKeys(rules)
+
+	for _, col := range cols {
+		for _, rule := range rules[col] {
+			if _, ok := rule.(uniqueRule); ok {
+				seen[col] = make(map[string]int)
+			}
+		}
+	}
+
+	for rowIdx, row := range table.Rows {
+		dataRow := rowIdx + 1 // 1-based data row index, excluding the header
+
+		for _, col := range cols {
+			colRules := rules[col]
+			idx, ok := colIndex[col]
+			if !ok || idx >= len(row) {
+				continue
+			}
+			value := row[idx].Value
+
+			for _, rule := range colRules {
+				ok, descriptor := checkRule(rule, value, table, colIndex, seen[col], dataRow)
+				if !ok {
+					if !emit(col, dataRow, value, descriptor) {
+						return errs
+					}
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// checkRule evaluates a single ColumnRule against value. table and
+// colIndex are only consulted by refInRule and may be nil for rule kinds
+// that don't need them (e.g. when checking a single streamed row).
+// colSeen tracks values already observed for this column and is only
+// consulted by uniqueRule; pass nil if the column has no ColumnUnique rule.
+func checkRule(rule ColumnRule, value string, table *Table, colIndex map[string]int, colSeen map[string]int, rowIdx int) (bool, string) {
+	switch r := rule.(type) {
+	case regexRule:
+		if value == "" || r.re.MatchString(value) {
+			return true, ""
+		}
+		return false, r.describe()
+	case rangeRule:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || n < r.min || n > r.max {
+			return false, r.describe()
+		}
+		return true, ""
+	case enumRule:
+		if value == "" {
+			return true, ""
+		}
+		for _, v := range r.values {
+			if v == value {
+				return true, ""
+			}
+		}
+		return false, r.describe()
+	case uniqueRule:
+		if value == "" {
+			return true, ""
+		}
+		firstRow, dup := colSeen[value]
+		if dup {
+			return false, fmt.Sprintf("%s (already seen at row %d)", r.describe(), firstRow)
+		}
+		colSeen[value] = rowIdx
+		return true, ""
+	case notBlankRule:
+		if strings.TrimSpace(value) == "" {
+			return false, r.describe()
+		}
+		return true, ""
+	case refInRule:
+		if value == "" {
+			return true, ""
+		}
+		otherIdx, ok := colIndex[r.otherColumn]
+		if !ok {
+			return false, fmt.Sprintf("column %q not found", r.otherColumn)
+		}
+		for _, row := range table.Rows {
+			if otherIdx < len(row) && row[otherIdx].Value == value {
+				return true, ""
+			}
+		}
+		return false, r.describe()
+	default:
+		return true, ""
+	}
+}
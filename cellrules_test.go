@@ -0,0 +1,98 @@
+package goxcel
+
+import (
+	"regexp"
+	"testing"
+)
+
+func rulesTestTable() *Table {
+	return &Table{
+		Headers: []string{"Code", "Score", "Status", "ID", "RefID"},
+		Rows: [][]Cell{
+			{{Value: "AB12"}, {Value: "50"}, {Value: "active"}, {Value: "1"}, {Value: "1"}},
+			{{Value: "bad"}, {Value: "500"}, {Value: "unknown"}, {Value: "1"}, {Value: "9"}},
+		},
+	}
+}
+
+func TestEvaluateValueRulesRegex(t *testing.T) {
+	table := rulesTestTable()
+	pattern := "^[A-Z]+[0-9]+$"
+	rules := map[string][]ColumnRule{"Code": {regexRule{pattern: pattern, re: regexp.MustCompile(pattern)}}}
+
+	errs := evaluateValueRules("Sheet1", "T", table, rules, 0)
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1: %+v", len(errs), errs)
+	}
+	if errs[0].Row != 2 || errs[0].Column != "Code" {
+		t.Errorf("unexpected error location: %+v", errs[0])
+	}
+}
+
+func TestEvaluateValueRulesRange(t *testing.T) {
+	table := rulesTestTable()
+	rules := map[string][]ColumnRule{"Score": {rangeRule{min: 0, max: 100}}}
+
+	errs := evaluateValueRules("Sheet1", "T", table, rules, 0)
+	if len(errs) != 1 || errs[0].Row != 2 {
+		t.Fatalf("got %+v, want a single violation on row 2", errs)
+	}
+}
+
+func TestEvaluateValueRulesEnum(t *testing.T) {
+	table := rulesTestTable()
+	rules := map[string][]ColumnRule{"Status": {enumRule{values: []string{"active", "inactive"}}}}
+
+	errs := evaluateValueRules("Sheet1", "T", table, rules, 0)
+	if len(errs) != 1 || errs[0].Actual != "unknown" {
+		t.Fatalf("got %+v, want a single violation with Actual=unknown", errs)
+	}
+}
+
+func TestEvaluateValueRulesUnique(t *testing.T) {
+	table := rulesTestTable()
+	rules := map[string][]ColumnRule{"ID": {uniqueRule{}}}
+
+	errs := evaluateValueRules("Sheet1", "T", table, rules, 0)
+	if len(errs) != 1 || errs[0].Row != 2 {
+		t.Fatalf("got %+v, want the second row's duplicate flagged", errs)
+	}
+}
+
+func TestEvaluateValueRulesRefIn(t *testing.T) {
+	table := rulesTestTable()
+	rules := map[string][]ColumnRule{"RefID": {refInRule{otherColumn: "ID"}}}
+
+	errs := evaluateValueRules("Sheet1", "T", table, rules, 0)
+	if len(errs) != 1 || errs[0].Actual != "9" {
+		t.Fatalf("got %+v, want row 2's RefID=9 flagged (not present in ID)", errs)
+	}
+}
+
+// TestEvaluateValueRulesDeterministicTruncation verifies that MaxCellErrors
+// truncates by column in sorted order every time, not map iteration order:
+// with 5 columns all violating and MaxCellErrors(2), the same two
+// (alphabetically first) columns must be reported across repeated runs.
+func TestEvaluateValueRulesDeterministicTruncation(t *testing.T) {
+	table := &Table{
+		Headers: []string{"A", "B", "C", "D", "E"},
+		Rows:    [][]Cell{{{Value: "x"}, {Value: "x"}, {Value: "x"}, {Value: "x"}, {Value: "x"}}},
+	}
+	rules := map[string][]ColumnRule{
+		"A": {enumRule{values: []string{"y"}}},
+		"B": {enumRule{values: []string{"y"}}},
+		"C": {enumRule{values: []string{"y"}}},
+		"D": {enumRule{values: []string{"y"}}},
+		"E": {enumRule{values: []string{"y"}}},
+	}
+
+	for i := 0; i < 10; i++ {
+		errs := evaluateValueRules("Sheet1", "T", table, rules, 2)
+		if len(errs) != 2 {
+			t.Fatalf("run %d: got %d errors, want 2", i, len(errs))
+		}
+		if errs[0].Column != "A" || errs[1].Column != "B" {
+			t.Fatalf("run %d: got columns %q, %q, want A, B", i, errs[0].Column, errs[1].Column)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Command goxcel validates Excel workbooks against declarative templates
+// from the command line, so CI pipelines (e.g. GitHub Actions) can gate
+// pull requests that touch data files without writing Go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/meddhiazoghlami/goxcel"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: goxcel <command> [arguments]")
+		return 2
+	}
+
+	switch args[0] {
+	case "validate":
+		return runValidate(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "goxcel: unknown command %q\n", args[0])
+		return 2
+	}
+}
+
+func runValidate(args []string) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	templatePath := fs.String("template", "", "path to a declarative template file (.yaml, .yml, or .json)")
+	format := fs.String("format", "text", "report format: text, json, junit, or sarif")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *templatePath == "" {
+		fmt.Fprintln(os.Stderr, "goxcel validate: -template is required")
+		return 2
+	}
+	workbookPaths := fs.Args()
+	if len(workbookPaths) == 0 {
+		fmt.Fprintln(os.Stderr, "goxcel validate: at least one workbook path is required")
+		return 2
+	}
+
+	tmpl, err := goxcel.LoadTemplateFile(*templatePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "goxcel validate: %v\n", err)
+		return 2
+	}
+
+	allValid := true
+	for _, path := range workbookPaths {
+		wb, err := goxcel.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "goxcel validate: %v\n", err)
+			return 2
+		}
+
+		result := goxcel.ValidateTemplate(wb, tmpl)
+		if !result.Valid {
+			allValid = false
+		}
+
+		if err := writeReport(os.Stdout, result, *format, path); err != nil {
+			fmt.Fprintf(os.Stderr, "goxcel validate: %v\n", err)
+			return 2
+		}
+	}
+
+	if !allValid {
+		return 1
+	}
+	return 0
+}
+
+// writeReport renders result in the requested format. "text" is the only
+// format not already implemented on ValidationResult itself (Summary),
+// so it's handled inline here.
+func writeReport(w *os.File, result *goxcel.ValidationResult, format, path string) error {
+	switch format {
+	case "text":
+		fmt.Fprintf(w, "%s: %s\n", path, result.Summary())
+		return nil
+	case "json":
+		data, err := result.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	case "junit":
+		return result.ToJUnit(w)
+	case "sarif":
+		return result.ToSARIF(w)
+	default:
+		return fmt.Errorf("unrecognized -format %q (want text, json, junit, or sarif)", format)
+	}
+}
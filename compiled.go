@@ -0,0 +1,109 @@
+package goxcel
+
+import "fmt"
+
+// compiledSheetSchema holds the parts of a SheetSchema that can be derived
+// once and reused across many Validate calls: the allowed-columns set used
+// by strict mode and the schema itself (its ValueRules already carry
+// compiled regex objects from ColumnRegex).
+type compiledSheetSchema struct {
+	schema      SheetSchema
+	allowedCols map[string]bool
+}
+
+func compileSheetSchema(schema SheetSchema) *compiledSheetSchema {
+	return &compiledSheetSchema{schema: schema, allowedCols: allowedColumns(schema)}
+}
+
+// CompiledTemplate is a Template with its per-sheet lookup structures
+// precomputed. Build one with CompileTemplate when the same Template will
+// validate many workbooks (e.g. an upload endpoint), so that work isn't
+// redone on every call the way plain ValidateTemplate redoes it.
+//
+// A CompiledTemplate is read-only after CompileTemplate returns, so
+// (*CompiledTemplate).Validate is safe to call concurrently from multiple
+// goroutines.
+type CompiledTemplate struct {
+	tmpl   Template
+	sheets map[string]*compiledSheetSchema
+}
+
+// CompileTemplate precomputes tmpl's per-sheet lookup structures and
+// catches schema authoring mistakes up front: a ColumnType or value rule
+// (ColumnRegex, ColumnRange, ...) declared for a column that isn't listed
+// in that sheet's RequiredColumns or OptionalColumns is an error here
+// rather than a silently-skipped rule at validation time.
+func CompileTemplate(tmpl Template) (*CompiledTemplate, error) {
+	ct := &CompiledTemplate{
+		tmpl:   tmpl,
+		sheets: make(map[string]*compiledSheetSchema, len(tmpl.SheetSchemas)),
+	}
+
+	for _, name := range sortedKeys(tmpl.SheetSchemas) {
+		schema := tmpl.SheetSchemas[name]
+		compiled := compileSheetSchema(schema)
+
+		for _, col := range sortedKeys(schema.ColumnTypes) {
+			if !compiled.allowedCols[col] {
+				return nil, fmt.Errorf("goxcel: sheet %q: ColumnType declared for %q, which is not a required or optional column", name, col)
+			}
+		}
+		for _, col := range sortedKeys(schema.ValueRules) {
+			if !compiled.allowedCols[col] {
+				return nil, fmt.Errorf("goxcel: sheet %q: value rule declared for %q, which is not a required or optional column", name, col)
+			}
+		}
+
+		ct.sheets[name] = compiled
+	}
+
+	return ct, nil
+}
+
+// Validate validates workbook against the compiled template, with the same
+// rules and error types as ValidateTemplate.
+func (ct *CompiledTemplate) Validate(workbook *Workbook) *ValidationResult {
+	result := &ValidationResult{Valid: true}
+
+	bySheet := make(map[string]*Sheet, len(workbook.Sheets))
+	for i := range workbook.Sheets {
+		bySheet[workbook.Sheets[i].Name] = &workbook.Sheets[i]
+	}
+
+	required := make(map[string]bool, len(ct.tmpl.RequiredSheets))
+	for _, name := range ct.tmpl.RequiredSheets {
+		required[name] = true
+		if _, ok := bySheet[name]; !ok {
+			result.addError(ValidationError{
+				Type:    ErrorMissingSheet,
+				Message: fmt.Sprintf("required sheet %q not found", name),
+				Sheet:   name,
+			})
+			continue
+		}
+		result.SheetsValidated = append(result.SheetsValidated, name)
+	}
+
+	if ct.tmpl.StrictSheetsMode {
+		for _, sheet := range workbook.Sheets {
+			if !required[sheet.Name] {
+				result.addError(ValidationError{
+					Type:    ErrorUnexpectedSheet,
+					Message: fmt.Sprintf("unexpected sheet %q in strict mode", sheet.Name),
+					Sheet:   sheet.Name,
+				})
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(ct.sheets) {
+		sheet, ok := bySheet[name]
+		if !ok {
+			continue
+		}
+		compiled := ct.sheets[name]
+		validateSheetCore(sheet, compiled.schema, compiled.allowedCols, result)
+	}
+
+	return result
+}
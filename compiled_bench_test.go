@@ -0,0 +1,91 @@
+package goxcel
+
+import (
+	"sync"
+	"testing"
+)
+
+func compiledBenchWorkbook() *Workbook {
+	rows := make([][]Cell, 0, 200)
+	for i := 0; i < 200; i++ {
+		rows = append(rows, []Cell{
+			{Value: "ID" + string(rune('A'+i%26)), Type: CellTypeString},
+			{Value: "user@example.com", Type: CellTypeString},
+			{Value: "42", Type: CellTypeNumber},
+		})
+	}
+	return &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Sheet1",
+				Tables: []Table{
+					{Headers: []string{"ID", "Email", "Age"}, Rows: rows},
+				},
+			},
+		},
+	}
+}
+
+// BenchmarkValidateTemplate_Uncompiled measures repeated validation of the
+// same workbook/template pair via the plain ValidateTemplate entry point,
+// which rebuilds its allowed-columns set on every call.
+func BenchmarkValidateTemplate_Uncompiled(b *testing.B) {
+	wb := compiledBenchWorkbook()
+	tmpl := benchTemplate()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ValidateTemplate(wb, tmpl)
+	}
+}
+
+// BenchmarkCompiledTemplate_Validate measures the same workload against a
+// CompiledTemplate built once outside the loop.
+func BenchmarkCompiledTemplate_Validate(b *testing.B) {
+	wb := compiledBenchWorkbook()
+	compiled, err := CompileTemplate(benchTemplate())
+	if err != nil {
+		b.Fatalf("CompileTemplate: %v", err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		compiled.Validate(wb)
+	}
+}
+
+// TestCompiledTemplateConcurrentUse exercises Validate from many goroutines
+// at once; run with -race to confirm CompiledTemplate carries no shared
+// mutable state across calls.
+func TestCompiledTemplateConcurrentUse(t *testing.T) {
+	wb := compiledBenchWorkbook()
+	compiled, err := CompileTemplate(benchTemplate())
+	if err != nil {
+		t.Fatalf("CompileTemplate: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := compiled.Validate(wb)
+			if !result.Valid {
+				t.Errorf("unexpected validation errors: %v", result.Errors)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCompileTemplateRejectsUnknownColumn(t *testing.T) {
+	schema := NewSchema().
+		RequireColumns("Name").
+		ColumnType("Ghost", CellTypeNumber).
+		Build()
+	tmpl := NewTemplate("Bad").Sheet("Sheet1", schema).Build()
+
+	if _, err := CompileTemplate(tmpl); err == nil {
+		t.Fatal("expected CompileTemplate to reject a ColumnType on a column outside required/optional columns")
+	}
+}
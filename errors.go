@@ -0,0 +1,82 @@
+package goxcel
+
+// ErrorType identifies the category of a validation failure so callers can
+// branch on it without parsing Message strings.
+type ErrorType int
+
+const (
+	ErrorMissingSheet ErrorType = iota
+	ErrorUnexpectedSheet
+	ErrorMissingColumn
+	ErrorUnexpectedColumn
+	ErrorColumnType
+	ErrorColumnOrder
+	ErrorRowCount
+	ErrorCellValue
+)
+
+// String returns a human-readable name for the error type, used when
+// rendering validation reports.
+func (e ErrorType) String() string {
+	switch e {
+	case ErrorMissingSheet:
+		return "MissingSheet"
+	case ErrorUnexpectedSheet:
+		return "UnexpectedSheet"
+	case ErrorMissingColumn:
+		return "MissingColumn"
+	case ErrorUnexpectedColumn:
+		return "UnexpectedColumn"
+	case ErrorColumnType:
+		return "ColumnType"
+	case ErrorColumnOrder:
+		return "ColumnOrder"
+	case ErrorRowCount:
+		return "RowCount"
+	case ErrorCellValue:
+		return "CellValue"
+	default:
+		return "Unknown"
+	}
+}
+
+// RuleID returns a stable, dotted-path-free identifier for the error type,
+// suitable for machine-readable reports (JSON, JUnit, SARIF) that need an
+// ID that won't change if String()'s wording does.
+func (e ErrorType) RuleID() string {
+	switch e {
+	case ErrorMissingSheet:
+		return "goxcel/missing-sheet"
+	case ErrorUnexpectedSheet:
+		return "goxcel/strict-sheets"
+	case ErrorMissingColumn:
+		return "goxcel/missing-column"
+	case ErrorUnexpectedColumn:
+		return "goxcel/strict-columns"
+	case ErrorColumnType:
+		return "goxcel/column-type"
+	case ErrorColumnOrder:
+		return "goxcel/column-order"
+	case ErrorRowCount:
+		return "goxcel/row-count"
+	case ErrorCellValue:
+		return "goxcel/cell-value"
+	default:
+		return "goxcel/unknown"
+	}
+}
+
+// ValidationError describes a single rule violation found while validating
+// a workbook against a Template. Not every field applies to every error
+// type; Row and Column are zero/empty for sheet-level errors.
+type ValidationError struct {
+	Type     ErrorType
+	Message  string
+	Sheet    string
+	Table    string
+	Column   string
+	Row      int    // 1-based data row index; 0 when the error isn't row-specific
+	Ref      string // A1-style cell reference (e.g. "B3"); empty when the error isn't cell-specific
+	Expected string
+	Actual   string
+}
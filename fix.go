@@ -0,0 +1,255 @@
+package goxcel
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FixOptions controls which automatic repairs ValidateAndFix is allowed to
+// perform. Each field gates one repair kind; leaving a field at its zero
+// value leaves that kind of violation for validation to report instead.
+type FixOptions struct {
+	// HeaderAliases maps an observed header name to the canonical name a
+	// schema expects (e.g. {"Qty": "Quantity"}), so renamed or
+	// differently-spelled columns don't fail validation.
+	HeaderAliases map[string]string
+	// TrimWhitespace trims leading/trailing whitespace from every header
+	// and cell value before validation.
+	TrimWhitespace bool
+	// CoerceNumericStrings retypes a cell as CellTypeNumber when its value
+	// parses as a number but was read with a different type, for columns
+	// where ColumnType declares CellTypeNumber.
+	CoerceNumericStrings bool
+	// AllowDrop removes columns not listed in RequiredColumns or
+	// OptionalColumns from a StrictColumns schema, rather than leaving
+	// them to fail validation as unexpected columns.
+	AllowDrop bool
+	// ReorderColumns moves RequiredColumns into their declared order when
+	// a schema uses ExpectOrder, rather than leaving the order violation
+	// to fail validation.
+	ReorderColumns bool
+}
+
+// FixChange records a single repair ValidateAndFix applied to the
+// workbook. Kind is one of "rename-header", "trim", "coerce-number",
+// "drop-column", or "reorder".
+type FixChange struct {
+	Sheet  string
+	Table  string
+	Column string
+	Kind   string
+	Before string
+	After  string
+}
+
+// FixReport lists every repair ValidateAndFix applied, in the order they
+// were made, so callers can log or reject the fixed workbook.
+type FixReport struct {
+	Changes []FixChange
+}
+
+func (r *FixReport) record(sheet, table, column, kind, before, after string) {
+	r.Changes = append(r.Changes, FixChange{
+		Sheet:  sheet,
+		Table:  table,
+		Column: column,
+		Kind:   kind,
+		Before: before,
+		After:  after,
+	})
+}
+
+// ValidateAndFix repairs common, mechanical template violations in
+// workbook in place according to opts — renamed headers, stray
+// whitespace, numeric strings stored as text, strict-mode extra columns,
+// and out-of-order columns — then validates the repaired workbook against
+// tmpl. The returned FixReport records every repair made, even when the
+// result is still invalid (e.g. a missing column no repair could add).
+func ValidateAndFix(workbook *Workbook, tmpl Template, opts FixOptions) (*ValidationResult, *FixReport) {
+	report := &FixReport{}
+
+	bySheet := make(map[string]*Sheet, len(workbook.Sheets))
+	for i := range workbook.Sheets {
+		bySheet[workbook.Sheets[i].Name] = &workbook.Sheets[i]
+	}
+
+	for _, name := range sortedKeys(tmpl.SheetSchemas) {
+		schema := tmpl.SheetSchemas[name]
+		sheet, ok := bySheet[name]
+		if !ok {
+			continue
+		}
+		table := findTable(sheet, schema.TableName)
+		if table == nil {
+			continue
+		}
+		applyFixes(sheet.Name, table, schema, opts, report)
+	}
+
+	return ValidateTemplate(workbook, tmpl), report
+}
+
+func applyFixes(sheetName string, table *Table, schema SheetSchema, opts FixOptions, report *FixReport) {
+	if len(opts.HeaderAliases) > 0 {
+		renameHeaders(sheetName, table, opts.HeaderAliases, report)
+	}
+	if opts.TrimWhitespace {
+		trimTable(sheetName, table, report)
+	}
+	if opts.CoerceNumericStrings {
+		coerceNumericColumns(sheetName, table, schema, report)
+	}
+	if opts.AllowDrop && schema.StrictColumnsMode {
+		dropUnknownColumns(sheetName, table, allowedColumns(schema), report)
+	}
+	if opts.ReorderColumns && schema.ExpectOrderMode {
+		reorderColumns(sheetName, table, schema.RequiredColumns, report)
+	}
+}
+
+func renameHeaders(sheetName string, table *Table, aliases map[string]string, report *FixReport) {
+	for i, h := range table.Headers {
+		canonical, ok := aliases[h]
+		if !ok || canonical == h {
+			continue
+		}
+		table.Headers[i] = canonical
+		report.record(sheetName, table.Name, canonical, "rename-header", h, canonical)
+	}
+}
+
+func trimTable(sheetName string, table *Table, report *FixReport) {
+	for i, h := range table.Headers {
+		trimmed := strings.TrimSpace(h)
+		if trimmed == h {
+			continue
+		}
+		table.Headers[i] = trimmed
+		report.record(sheetName, table.Name, trimmed, "trim", h, trimmed)
+	}
+	for _, row := range table.Rows {
+		for i := range row {
+			trimmed := strings.TrimSpace(row[i].Value)
+			if trimmed == row[i].Value {
+				continue
+			}
+			col := ""
+			if i < len(table.Headers) {
+				col = table.Headers[i]
+			}
+			report.record(sheetName, table.Name, col, "trim", row[i].Value, trimmed)
+			row[i].Value = trimmed
+		}
+	}
+}
+
+func coerceNumericColumns(sheetName string, table *Table, schema SheetSchema, report *FixReport) {
+	for _, col := range sortedKeys(schema.ColumnTypes) {
+		if schema.ColumnTypes[col] != CellTypeNumber {
+			continue
+		}
+		idx := -1
+		for i, h := range table.Headers {
+			if h == col {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			continue
+		}
+		for _, row := range table.Rows {
+			if idx >= len(row) {
+				continue
+			}
+			cell := &row[idx]
+			if cell.Type == CellTypeNumber || cell.Value == "" {
+				continue
+			}
+			if _, err := strconv.ParseFloat(cell.Value, 64); err != nil {
+				continue
+			}
+			report.record(sheetName, table.Name, col, "coerce-number", cell.Type.String(), CellTypeNumber.String())
+			cell.Type = CellTypeNumber
+		}
+	}
+}
+
+func dropUnknownColumns(sheetName string, table *Table, allowed map[string]bool, report *FixReport) {
+	var keep []int
+	var newHeaders []string
+	for i, h := range table.Headers {
+		if allowed[h] {
+			keep = append(keep, i)
+			newHeaders = append(newHeaders, h)
+			continue
+		}
+		report.record(sheetName, table.Name, h, "drop-column", h, "")
+	}
+	if len(keep) == len(table.Headers) {
+		return
+	}
+
+	table.Headers = newHeaders
+	for r, row := range table.Rows {
+		newRow := make([]Cell, 0, len(keep))
+		for _, i := range keep {
+			if i < len(row) {
+				newRow = append(newRow, row[i])
+			}
+		}
+		table.Rows[r] = newRow
+	}
+}
+
+func reorderColumns(sheetName string, table *Table, required []string, report *FixReport) {
+	positions := make(map[string]int, len(table.Headers))
+	for i, h := range table.Headers {
+		positions[h] = i
+	}
+
+	// Desired order: RequiredColumns in their declared order, followed by
+	// every other existing column in its current relative order.
+	wanted := make([]string, 0, len(table.Headers))
+	placed := make(map[string]bool, len(required))
+	for _, col := range required {
+		if _, ok := positions[col]; ok {
+			wanted = append(wanted, col)
+			placed[col] = true
+		}
+	}
+	for _, h := range table.Headers {
+		if !placed[h] {
+			wanted = append(wanted, h)
+		}
+	}
+
+	same := true
+	for i, h := range wanted {
+		if table.Headers[i] != h {
+			same = false
+			break
+		}
+	}
+	if same {
+		return
+	}
+
+	oldOrder := strings.Join(table.Headers, ",")
+	perm := make([]int, len(wanted))
+	for i, h := range wanted {
+		perm[i] = positions[h]
+	}
+
+	table.Headers = wanted
+	for r, row := range table.Rows {
+		newRow := make([]Cell, len(perm))
+		for i, oldIdx := range perm {
+			if oldIdx < len(row) {
+				newRow[i] = row[oldIdx]
+			}
+		}
+		table.Rows[r] = newRow
+	}
+	report.record(sheetName, table.Name, "", "reorder", oldOrder, strings.Join(wanted, ","))
+}
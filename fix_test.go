@@ -0,0 +1,96 @@
+package goxcel
+
+import "testing"
+
+func fixTestWorkbook() *Workbook {
+	return &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Orders",
+				Tables: []Table{
+					{
+						Headers: []string{"ID", " Qty ", "Status", "Notes"},
+						Rows: [][]Cell{
+							{{Value: "1", Type: CellTypeNumber}, {Value: " 5 ", Type: CellTypeString}, {Value: "ok", Type: CellTypeString}, {Value: "extra", Type: CellTypeString}},
+							{{Value: "2", Type: CellTypeNumber}, {Value: "7", Type: CellTypeString}, {Value: "ok", Type: CellTypeString}, {Value: "extra", Type: CellTypeString}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixTestTemplate() Template {
+	schema := NewSchema().
+		RequireColumns("ID", "Quantity", "Status").
+		StrictColumns().
+		ExpectOrder().
+		ColumnType("Quantity", CellTypeNumber).
+		Build()
+	return NewTemplate("orders").RequireSheets("Orders").Sheet("Orders", schema).Build()
+}
+
+func TestValidateAndFixRepairsViolations(t *testing.T) {
+	wb := fixTestWorkbook()
+	opts := FixOptions{
+		HeaderAliases:        map[string]string{" Qty ": "Quantity"},
+		TrimWhitespace:       true,
+		CoerceNumericStrings: true,
+		AllowDrop:            true,
+		ReorderColumns:       true,
+	}
+
+	result, report := ValidateAndFix(wb, fixTestTemplate(), opts)
+
+	if !result.Valid {
+		t.Fatalf("expected valid result after fixing, got errors: %+v", result.Errors)
+	}
+	if len(report.Changes) == 0 {
+		t.Fatal("expected FixReport to record changes")
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	wantHeaders := []string{"ID", "Quantity", "Status"}
+	if len(table.Headers) != len(wantHeaders) {
+		t.Fatalf("headers = %v, want %v", table.Headers, wantHeaders)
+	}
+	for i, h := range wantHeaders {
+		if table.Headers[i] != h {
+			t.Errorf("headers[%d] = %q, want %q", i, table.Headers[i], h)
+		}
+	}
+	if table.Rows[0][1].Value != "5" || table.Rows[0][1].Type != CellTypeNumber {
+		t.Errorf("Quantity cell = %+v, want trimmed numeric 5", table.Rows[0][1])
+	}
+}
+
+func TestValidateAndFixLeavesUnfixableErrors(t *testing.T) {
+	wb := &Workbook{Sheets: []Sheet{{Name: "Orders", Tables: []Table{{Headers: []string{"ID"}}}}}}
+	result, report := ValidateAndFix(wb, fixTestTemplate(), FixOptions{})
+
+	if result.Valid {
+		t.Fatal("expected missing-column errors to remain when no repair applies")
+	}
+	if len(report.Changes) != 0 {
+		t.Errorf("expected no changes when FixOptions is zero, got %+v", report.Changes)
+	}
+}
+
+func TestValidateAndFixAllowDropRequiresStrictColumns(t *testing.T) {
+	schema := NewSchema().RequireColumns("ID").Build()
+	tmpl := NewTemplate("t").RequireSheets("Orders").Sheet("Orders", schema).Build()
+	wb := &Workbook{Sheets: []Sheet{{Name: "Orders", Tables: []Table{{
+		Headers: []string{"ID", "Extra"},
+		Rows:    [][]Cell{{{Value: "1", Type: CellTypeNumber}, {Value: "x", Type: CellTypeString}}},
+	}}}}}
+
+	_, report := ValidateAndFix(wb, tmpl, FixOptions{AllowDrop: true})
+
+	if len(report.Changes) != 0 {
+		t.Errorf("expected no drop without StrictColumns, got %+v", report.Changes)
+	}
+	if len(wb.Sheets[0].Tables[0].Headers) != 2 {
+		t.Errorf("expected Extra column to remain, headers = %v", wb.Sheets[0].Tables[0].Headers)
+	}
+}
@@ -0,0 +1,291 @@
+// Package yamllite decodes the small subset of YAML that goxcel's
+// declarative template files need: block and flow mappings, block and flow
+// sequences, and scalar strings/numbers/bools/null. It deliberately does
+// not support anchors, aliases, multi-document streams, or multi-line
+// scalars — goxcel has no use for them and pulling in a full YAML
+// implementation isn't worth the dependency for a template format this
+// small.
+package yamllite
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse decodes data into a tree of map[string]any, []any, and scalar
+// values (string, float64, bool, nil), mirroring what encoding/json
+// produces when unmarshaling into an any.
+func Parse(data []byte) (any, error) {
+	lines, err := tokenize(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	value, consumed, err := parseBlock(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if consumed != len(lines) {
+		return nil, fmt.Errorf("yamllite: unexpected content at line %d", lines[consumed].num)
+	}
+	return value, nil
+}
+
+type line struct {
+	indent int
+	text   string // content with indentation and trailing comment stripped
+	num    int    // 1-based source line number, for error messages
+}
+
+func tokenize(src string) ([]line, error) {
+	var lines []line
+	for i, raw := range strings.Split(src, "\n") {
+		trimmedRight := strings.TrimRight(raw, " \t\r")
+		content := stripComment(trimmedRight)
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+		indent := len(content) - len(strings.TrimLeft(content, " "))
+		lines = append(lines, line{indent: indent, text: strings.TrimLeft(content, " "), num: i + 1})
+	}
+	return lines, nil
+}
+
+// stripComment removes a trailing "# ..." comment, respecting quoted
+// strings so "#" inside a value isn't mistaken for a comment marker.
+func stripComment(s string) string {
+	inSingle, inDouble := false, false
+	for i, r := range s {
+		switch r {
+		case '\'':
+			if !inDouble {
+				inSingle = !inSingle
+			}
+		case '"':
+			if !inSingle {
+				inDouble = !inDouble
+			}
+		case '#':
+			if !inSingle && !inDouble && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t') {
+				return s[:i]
+			}
+		}
+	}
+	return s
+}
+
+// parseBlock parses a sequence of sibling lines at exactly the given
+// indent, returning the decoded value and how many lines it consumed.
+func parseBlock(lines []line, start, indent int) (any, int, error) {
+	if start >= len(lines) || lines[start].indent != indent {
+		return nil, start, fmt.Errorf("yamllite: expected content at indent %d", indent)
+	}
+	if strings.HasPrefix(lines[start].text, "- ") || lines[start].text == "-" {
+		return parseSequence(lines, start, indent)
+	}
+	return parseMapping(lines, start, indent)
+}
+
+func parseSequence(lines []line, start, indent int) (any, int, error) {
+	var items []any
+	i := start
+	for i < len(lines) && lines[i].indent == indent && (lines[i].text == "-" || strings.HasPrefix(lines[i].text, "- ")) {
+		rest := strings.TrimPrefix(lines[i].text, "-")
+		rest = strings.TrimPrefix(rest, " ")
+		if rest == "" {
+			// Item is a nested block on the following, more-indented lines.
+			if i+1 >= len(lines) || lines[i+1].indent <= indent {
+				items = append(items, nil)
+				i++
+				continue
+			}
+			value, consumed, err := parseBlock(lines, i+1, lines[i+1].indent)
+			if err != nil {
+				return nil, i, err
+			}
+			items = append(items, value)
+			i = consumed
+			continue
+		}
+		if key, val, ok := splitKeyValue(rest); ok {
+			// "- key: value" starts an inline single-line mapping entry;
+			// further "key: value" lines indented to match rest's column
+			// continue the same map item.
+			entryIndent := indent + (len(lines[i].text) - len(rest))
+			obj := map[string]any{}
+			if err := setScalarKV(obj, key, val); err != nil {
+				return nil, i, err
+			}
+			i++
+			for i < len(lines) && lines[i].indent == entryIndent {
+				k, v, ok := splitKeyValue(lines[i].text)
+				if !ok {
+					break
+				}
+				if err := setScalarKV(obj, k, v); err != nil {
+					return nil, i, err
+				}
+				i++
+			}
+			items = append(items, obj)
+			continue
+		}
+		items = append(items, parseScalar(rest))
+		i++
+	}
+	return items, i, nil
+}
+
+func parseMapping(lines []line, start, indent int) (any, int, error) {
+	obj := map[string]any{}
+	i := start
+	for i < len(lines) && lines[i].indent == indent {
+		key, val, ok := splitKeyValue(lines[i].text)
+		if !ok {
+			return nil, i, fmt.Errorf("yamllite: line %d: expected \"key: value\"", lines[i].num)
+		}
+		if val != "" {
+			if _, exists := obj[key]; exists {
+				return nil, i, fmt.Errorf("yamllite: line %d: duplicate key %q", lines[i].num, key)
+			}
+			obj[key] = parseScalar(val)
+			i++
+			continue
+		}
+		if i+1 < len(lines) && lines[i+1].indent > indent {
+			child, consumed, err := parseBlock(lines, i+1, lines[i+1].indent)
+			if err != nil {
+				return nil, i, err
+			}
+			obj[key] = child
+			i = consumed
+			continue
+		}
+		obj[key] = nil
+		i++
+	}
+	return obj, i, nil
+}
+
+// splitKeyValue splits "key: value" (or "key:") at the first unquoted
+// colon followed by a space or end of line.
+func splitKeyValue(s string) (key, value string, ok bool) {
+	inSingle, inDouble := false, false
+	for i, r := range s {
+		switch r {
+		case '\'':
+			if !inDouble {
+				inSingle = !inSingle
+			}
+		case '"':
+			if !inSingle {
+				inDouble = !inDouble
+			}
+		case ':':
+			if inSingle || inDouble {
+				continue
+			}
+			if i+1 == len(s) || s[i+1] == ' ' {
+				return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func setScalarKV(obj map[string]any, key, val string) error {
+	if val == "" {
+		obj[key] = nil
+		return nil
+	}
+	obj[key] = parseScalar(val)
+	return nil
+}
+
+// parseScalar decodes a scalar or flow-style ([...] / {...}) value.
+func parseScalar(s string) any {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "" || s == "~" || s == "null":
+		return nil
+	case s == "true":
+		return true
+	case s == "false":
+		return false
+	case strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]"):
+		return parseFlowSequence(s[1 : len(s)-1])
+	case strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"):
+		return parseFlowMapping(s[1 : len(s)-1])
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		return s[1 : len(s)-1]
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return s[1 : len(s)-1]
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return n
+	}
+	return s
+}
+
+func parseFlowSequence(s string) []any {
+	var items []any
+	for _, part := range splitFlow(s) {
+		if part == "" {
+			continue
+		}
+		items = append(items, parseScalar(part))
+	}
+	return items
+}
+
+func parseFlowMapping(s string) map[string]any {
+	obj := map[string]any{}
+	for _, part := range splitFlow(s) {
+		if part == "" {
+			continue
+		}
+		if key, val, ok := strings.Cut(part, ":"); ok {
+			obj[strings.TrimSpace(key)] = parseScalar(val)
+		}
+	}
+	return obj
+}
+
+// splitFlow splits a flow collection's inner content on top-level commas,
+// ignoring commas nested inside quotes or brackets.
+func splitFlow(s string) []string {
+	var parts []string
+	depth := 0
+	inSingle, inDouble := false, false
+	last := 0
+	for i, r := range s {
+		switch r {
+		case '\'':
+			if !inDouble {
+				inSingle = !inSingle
+			}
+		case '"':
+			if !inSingle {
+				inDouble = !inDouble
+			}
+		case '[', '{':
+			if !inSingle && !inDouble {
+				depth++
+			}
+		case ']', '}':
+			if !inSingle && !inDouble {
+				depth--
+			}
+		case ',':
+			if depth == 0 && !inSingle && !inDouble {
+				parts = append(parts, strings.TrimSpace(s[last:i]))
+				last = i + 1
+			}
+		}
+	}
+	parts = append(parts, strings.TrimSpace(s[last:]))
+	return parts
+}
@@ -0,0 +1,88 @@
+package yamllite
+
+import "testing"
+
+const doc = `
+name: FullTemplate
+requiredSheets: [Sheet1]
+strictSheets: false
+sheets:
+  Sheet1:
+    requiredColumns: [Name, Email]
+    optionalColumns: [Value]
+    rowCount: {min: 1, max: 100}
+    columnTypes:
+      Value: number
+    rules:
+      Email:
+        - regex: "^[^@]+@[^@]+$"
+      Name:
+        - notBlank: true
+        - unique: true
+`
+
+func TestParse(t *testing.T) {
+	v, err := Parse([]byte(doc))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	root, ok := v.(map[string]any)
+	if !ok {
+		t.Fatalf("root is %T, want map[string]any", v)
+	}
+	if root["name"] != "FullTemplate" {
+		t.Errorf("name = %v, want FullTemplate", root["name"])
+	}
+
+	sheets, ok := root["sheets"].(map[string]any)
+	if !ok {
+		t.Fatalf("sheets is %T, want map[string]any", root["sheets"])
+	}
+	sheet1, ok := sheets["Sheet1"].(map[string]any)
+	if !ok {
+		t.Fatalf("sheets.Sheet1 is %T, want map[string]any", sheets["Sheet1"])
+	}
+
+	required, ok := sheet1["requiredColumns"].([]any)
+	if !ok || len(required) != 2 || required[0] != "Name" || required[1] != "Email" {
+		t.Errorf("requiredColumns = %#v, want [Name Email]", sheet1["requiredColumns"])
+	}
+
+	rowCount, ok := sheet1["rowCount"].(map[string]any)
+	if !ok || rowCount["min"] != 1.0 || rowCount["max"] != 100.0 {
+		t.Errorf("rowCount = %#v, want {min:1 max:100}", sheet1["rowCount"])
+	}
+
+	rules, ok := sheet1["rules"].(map[string]any)
+	if !ok {
+		t.Fatalf("rules is %T, want map[string]any", sheet1["rules"])
+	}
+	emailRules, ok := rules["Email"].([]any)
+	if !ok || len(emailRules) != 1 {
+		t.Fatalf("rules.Email = %#v, want one entry", rules["Email"])
+	}
+	emailRule, ok := emailRules[0].(map[string]any)
+	if !ok || emailRule["regex"] != "^[^@]+@[^@]+$" {
+		t.Errorf("rules.Email[0] = %#v", emailRules[0])
+	}
+
+	nameRules, ok := rules["Name"].([]any)
+	if !ok || len(nameRules) != 2 {
+		t.Fatalf("rules.Name = %#v, want two entries", rules["Name"])
+	}
+}
+
+func TestParseComment(t *testing.T) {
+	v, err := Parse([]byte("name: Hello # a comment\ncount: 3\n"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	root := v.(map[string]any)
+	if root["name"] != "Hello" {
+		t.Errorf("name = %v, want Hello", root["name"])
+	}
+	if root["count"] != 3.0 {
+		t.Errorf("count = %v, want 3", root["count"])
+	}
+}
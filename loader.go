@@ -0,0 +1,377 @@
+package goxcel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/meddhiazoghlami/goxcel/internal/yamllite"
+)
+
+// LoadTemplateFile reads a declarative Template definition from a YAML or
+// JSON file, chosen by the file's extension (.yaml, .yml, or .json).
+func LoadTemplateFile(path string) (Template, error) {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if format == "yml" {
+		format = "yaml"
+	}
+	if format != "yaml" && format != "json" {
+		return Template{}, fmt.Errorf("goxcel: %s: unrecognized template file extension %q (want .yaml, .yml, or .json)", path, filepath.Ext(path))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return Template{}, fmt.Errorf("goxcel: open %q: %w", path, err)
+	}
+	defer f.Close()
+
+	return LoadTemplate(f, format)
+}
+
+// LoadTemplate parses a declarative Template definition from r in the
+// given format ("yaml" or "json") into the same Template/SheetSchema
+// structs NewTemplate/NewSchema build. It fails fast on unknown fields,
+// sheets referenced under "sheets" that aren't listed in
+// "requiredSheets", and column rules (columnTypes, rules) naming a column
+// that isn't listed in requiredColumns or optionalColumns for that sheet —
+// all of which are template-authoring typos better caught at load time
+// than silently ignored.
+func LoadTemplate(r io.Reader, format string) (Template, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Template{}, fmt.Errorf("goxcel: read template: %w", err)
+	}
+
+	var tree any
+	switch format {
+	case "json":
+		dec := json.NewDecoder(strings.NewReader(string(data)))
+		dec.UseNumber()
+		if err := dec.Decode(&tree); err != nil {
+			return Template{}, fmt.Errorf("goxcel: parse JSON template: %w", err)
+		}
+		tree = normalizeJSONNumbers(tree)
+	case "yaml":
+		tree, err = yamllite.Parse(data)
+		if err != nil {
+			return Template{}, fmt.Errorf("goxcel: parse YAML template: %w", err)
+		}
+	default:
+		return Template{}, fmt.Errorf("goxcel: unsupported template format %q (want \"yaml\" or \"json\")", format)
+	}
+
+	root, ok := asMap(tree)
+	if !ok {
+		return Template{}, fmt.Errorf("goxcel: template root must be a mapping")
+	}
+	return buildTemplate(root)
+}
+
+// normalizeJSONNumbers converts the json.Number values produced by
+// UseNumber() into float64, matching what yamllite produces for numeric
+// scalars, so the rest of the loader doesn't need to handle both.
+func normalizeJSONNumbers(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		f, _ := val.Float64()
+		return f
+	case map[string]any:
+		for k, child := range val {
+			val[k] = normalizeJSONNumbers(child)
+		}
+		return val
+	case []any:
+		for i, child := range val {
+			val[i] = normalizeJSONNumbers(child)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+var templateKeys = []string{"name", "requiredSheets", "strictSheets", "sheets"}
+
+func buildTemplate(root map[string]any) (Template, error) {
+	if err := checkKnownKeys(root, templateKeys, "template"); err != nil {
+		return Template{}, err
+	}
+
+	name, _ := asString(root["name"])
+	requiredSheets, err := asStringSlice(root["requiredSheets"])
+	if err != nil {
+		return Template{}, fmt.Errorf("goxcel: requiredSheets: %w", err)
+	}
+	strictSheets, _ := asBool(root["strictSheets"])
+
+	builder := NewTemplate(name).RequireSheets(requiredSheets...)
+	if strictSheets {
+		builder.StrictSheets()
+	}
+
+	sheetsRaw, ok := asMap(root["sheets"])
+	if !ok && root["sheets"] != nil {
+		return Template{}, fmt.Errorf("goxcel: sheets must be a mapping of sheet name to schema")
+	}
+
+	required := make(map[string]bool, len(requiredSheets))
+	for _, s := range requiredSheets {
+		required[s] = true
+	}
+
+	for sheetName, raw := range sheetsRaw {
+		if !required[sheetName] {
+			return Template{}, fmt.Errorf("goxcel: sheets.%s: sheet is not listed in requiredSheets", sheetName)
+		}
+		sheetObj, ok := asMap(raw)
+		if !ok {
+			return Template{}, fmt.Errorf("goxcel: sheets.%s: schema must be a mapping", sheetName)
+		}
+		schema, err := buildSheetSchema(sheetName, sheetObj)
+		if err != nil {
+			return Template{}, err
+		}
+		builder.Sheet(sheetName, schema)
+	}
+
+	return builder.Build(), nil
+}
+
+var sheetSchemaKeys = []string{
+	"table", "requiredColumns", "optionalColumns", "strictColumns",
+	"expectOrder", "rowCount", "allowEmpty", "columnTypes",
+	"typeStrictness", "maxCellErrors", "rules",
+}
+
+func buildSheetSchema(sheetName string, obj map[string]any) (SheetSchema, error) {
+	prefix := "sheets." + sheetName
+	if err := checkKnownKeys(obj, sheetSchemaKeys, prefix); err != nil {
+		return SheetSchema{}, err
+	}
+
+	required, err := asStringSlice(obj["requiredColumns"])
+	if err != nil {
+		return SheetSchema{}, fmt.Errorf("goxcel: %s.requiredColumns: %w", prefix, err)
+	}
+	optional, err := asStringSlice(obj["optionalColumns"])
+	if err != nil {
+		return SheetSchema{}, fmt.Errorf("goxcel: %s.optionalColumns: %w", prefix, err)
+	}
+	allowedCols := make(map[string]bool, len(required)+len(optional))
+	for _, c := range required {
+		allowedCols[c] = true
+	}
+	for _, c := range optional {
+		allowedCols[c] = true
+	}
+
+	builder := NewSchema().RequireColumns(required...).OptionalColumns(optional...)
+
+	if strictColumns, _ := asBool(obj["strictColumns"]); strictColumns {
+		builder.StrictColumns()
+	}
+	if expectOrder, _ := asBool(obj["expectOrder"]); expectOrder {
+		builder.ExpectOrder()
+	}
+
+	if rowCount, ok := asMap(obj["rowCount"]); ok {
+		if err := checkKnownKeys(rowCount, []string{"min", "max"}, prefix+".rowCount"); err != nil {
+			return SheetSchema{}, err
+		}
+		min, _ := asInt(rowCount["min"])
+		max, _ := asInt(rowCount["max"])
+		builder.RowCount(min, max)
+	}
+
+	if columnTypes, ok := asMap(obj["columnTypes"]); ok {
+		for col, raw := range columnTypes {
+			if !allowedCols[col] {
+				return SheetSchema{}, fmt.Errorf("goxcel: %s.columnTypes.%s: column is not in requiredColumns or optionalColumns", prefix, col)
+			}
+			typeName, _ := asString(raw)
+			ct, err := parseCellTypeName(typeName)
+			if err != nil {
+				return SheetSchema{}, fmt.Errorf("goxcel: %s.columnTypes.%s: %w", prefix, col, err)
+			}
+			builder.ColumnType(col, ct)
+		}
+	}
+
+	if strictness, ok := obj["typeStrictness"]; ok {
+		s, _ := asString(strictness)
+		switch s {
+		case "strict":
+			builder.TypeStrictness(TypeStrictnessStrict)
+		case "lenient":
+			builder.TypeStrictness(TypeStrictnessLenient)
+		default:
+			return SheetSchema{}, fmt.Errorf("goxcel: %s.typeStrictness: unknown value %q (want \"strict\" or \"lenient\")", prefix, s)
+		}
+	}
+
+	if maxCellErrors, ok := obj["maxCellErrors"]; ok {
+		n, _ := asInt(maxCellErrors)
+		builder.MaxCellErrors(n)
+	}
+
+	if rules, ok := asMap(obj["rules"]); ok {
+		if err := applyRules(prefix, builder, allowedCols, rules); err != nil {
+			return SheetSchema{}, err
+		}
+	}
+
+	schema := builder.Build()
+	schema.TableName, _ = asString(obj["table"])
+	schema.AllowEmpty, _ = asBool(obj["allowEmpty"])
+	return schema, nil
+}
+
+var ruleKeys = []string{"regex", "range", "enum", "unique", "notBlank", "refIn"}
+
+func applyRules(prefix string, builder *SchemaBuilder, allowedCols map[string]bool, rules map[string]any) error {
+	for col, raw := range rules {
+		if !allowedCols[col] {
+			return fmt.Errorf("goxcel: %s.rules.%s: column is not in requiredColumns or optionalColumns", prefix, col)
+		}
+		entries, ok := raw.([]any)
+		if !ok {
+			return fmt.Errorf("goxcel: %s.rules.%s: must be a list of rule entries", prefix, col)
+		}
+		for i, entry := range entries {
+			ruleObj, ok := asMap(entry)
+			if !ok {
+				return fmt.Errorf("goxcel: %s.rules.%s[%d]: must be a mapping", prefix, col, i)
+			}
+			if err := checkKnownKeys(ruleObj, ruleKeys, fmt.Sprintf("%s.rules.%s[%d]", prefix, col, i)); err != nil {
+				return err
+			}
+			if err := applyRule(builder, col, ruleObj); err != nil {
+				return fmt.Errorf("goxcel: %s.rules.%s[%d]: %w", prefix, col, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func applyRule(builder *SchemaBuilder, col string, ruleObj map[string]any) error {
+	matched := 0
+	if regex, ok := ruleObj["regex"]; ok {
+		pattern, _ := asString(regex)
+		rule, err := newRegexRule(pattern)
+		if err != nil {
+			return fmt.Errorf("regex: %w", err)
+		}
+		builder.addRule(col, rule)
+		matched++
+	}
+	if rangeObj, ok := asMap(ruleObj["range"]); ok {
+		min, _ := asFloat(rangeObj["min"])
+		max, _ := asFloat(rangeObj["max"])
+		builder.ColumnRange(col, min, max)
+		matched++
+	}
+	if enumVal, ok := ruleObj["enum"]; ok {
+		values, err := asStringSlice(enumVal)
+		if err != nil {
+			return fmt.Errorf("enum: %w", err)
+		}
+		builder.ColumnEnum(col, values...)
+		matched++
+	}
+	if unique, ok := ruleObj["unique"]; ok {
+		if b, _ := asBool(unique); b {
+			builder.ColumnUnique(col)
+		}
+		matched++
+	}
+	if notBlank, ok := ruleObj["notBlank"]; ok {
+		if b, _ := asBool(notBlank); b {
+			builder.ColumnNotBlank(col)
+		}
+		matched++
+	}
+	if refIn, ok := ruleObj["refIn"]; ok {
+		otherCol, _ := asString(refIn)
+		builder.ColumnRefIn(col, otherCol)
+		matched++
+	}
+	if matched != 1 {
+		return fmt.Errorf("expected exactly one of %v, got %d", ruleKeys, matched)
+	}
+	return nil
+}
+
+func parseCellTypeName(name string) (CellType, error) {
+	switch name {
+	case "string":
+		return CellTypeString, nil
+	case "number":
+		return CellTypeNumber, nil
+	case "bool":
+		return CellTypeBool, nil
+	case "date":
+		return CellTypeDate, nil
+	default:
+		return 0, fmt.Errorf("unknown cell type %q (want string, number, bool, or date)", name)
+	}
+}
+
+func checkKnownKeys(obj map[string]any, allowed []string, context string) error {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, k := range allowed {
+		allowedSet[k] = true
+	}
+	for _, k := range sortedKeys(obj) {
+		if !allowedSet[k] {
+			return fmt.Errorf("goxcel: %s: unknown field %q", context, k)
+		}
+	}
+	return nil
+}
+
+func asMap(v any) (map[string]any, bool) {
+	m, ok := v.(map[string]any)
+	return m, ok
+}
+
+func asString(v any) (string, bool) {
+	s, ok := v.(string)
+	return s, ok
+}
+
+func asBool(v any) (bool, bool) {
+	b, ok := v.(bool)
+	return b, ok
+}
+
+func asFloat(v any) (float64, bool) {
+	f, ok := v.(float64)
+	return f, ok
+}
+
+func asInt(v any) (int, bool) {
+	f, ok := v.(float64)
+	return int(f), ok
+}
+
+func asStringSlice(v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	items, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("must be a list of strings")
+	}
+	out := make([]string, len(items))
+	for i, item := range items {
+		s, ok := asString(item)
+		if !ok {
+			return nil, fmt.Errorf("item %d is not a string", i)
+		}
+		out[i] = s
+	}
+	return out, nil
+}
@@ -0,0 +1,145 @@
+package goxcel
+
+import (
+	"strings"
+	"testing"
+)
+
+const yamlTemplate = `
+name: FullTemplate
+requiredSheets: [Sheet1]
+sheets:
+  Sheet1:
+    requiredColumns: [Name, Email]
+    optionalColumns: [Age]
+    strictColumns: true
+    expectOrder: true
+    rowCount: {min: 1, max: 100}
+    columnTypes:
+      Age: number
+    rules:
+      Email:
+        - regex: "^[^@]+@[^@]+$"
+      Name:
+        - notBlank: true
+        - unique: true
+`
+
+func TestLoadTemplateYAML(t *testing.T) {
+	tmpl, err := LoadTemplate(strings.NewReader(yamlTemplate), "yaml")
+	if err != nil {
+		t.Fatalf("LoadTemplate: %v", err)
+	}
+	if tmpl.Name != "FullTemplate" {
+		t.Errorf("Name = %q, want FullTemplate", tmpl.Name)
+	}
+	if len(tmpl.RequiredSheets) != 1 || tmpl.RequiredSheets[0] != "Sheet1" {
+		t.Errorf("RequiredSheets = %v", tmpl.RequiredSheets)
+	}
+	schema, ok := tmpl.SheetSchemas["Sheet1"]
+	if !ok {
+		t.Fatal("missing Sheet1 schema")
+	}
+	if !schema.StrictColumnsMode || !schema.ExpectOrderMode {
+		t.Errorf("expected strict columns and expect-order to be enabled")
+	}
+	if schema.MinRows != 1 || schema.MaxRows != 100 {
+		t.Errorf("rowCount = [%d, %d], want [1, 100]", schema.MinRows, schema.MaxRows)
+	}
+	if schema.ColumnTypes["Age"] != CellTypeNumber {
+		t.Errorf("columnTypes[Age] = %v, want CellTypeNumber", schema.ColumnTypes["Age"])
+	}
+	if len(schema.ValueRules["Email"]) != 1 || len(schema.ValueRules["Name"]) != 2 {
+		t.Errorf("unexpected ValueRules: %+v", schema.ValueRules)
+	}
+}
+
+const jsonTemplate = `{
+	"name": "FullTemplate",
+	"requiredSheets": ["Sheet1"],
+	"sheets": {
+		"Sheet1": {
+			"requiredColumns": ["Name", "Email"],
+			"rules": {
+				"Email": [{"regex": "^[^@]+@[^@]+$"}]
+			}
+		}
+	}
+}`
+
+func TestLoadTemplateJSON(t *testing.T) {
+	tmpl, err := LoadTemplate(strings.NewReader(jsonTemplate), "json")
+	if err != nil {
+		t.Fatalf("LoadTemplate: %v", err)
+	}
+	if tmpl.Name != "FullTemplate" {
+		t.Errorf("Name = %q, want FullTemplate", tmpl.Name)
+	}
+	if len(tmpl.SheetSchemas["Sheet1"].ValueRules["Email"]) != 1 {
+		t.Errorf("expected one Email rule")
+	}
+}
+
+func TestLoadTemplateRejectsUnknownField(t *testing.T) {
+	const doc = `
+name: T
+requiredSheets: [Sheet1]
+sheets:
+  Sheet1:
+    requiredColumns: [Name]
+    bogusField: true
+`
+	if _, err := LoadTemplate(strings.NewReader(doc), "yaml"); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
+
+func TestLoadTemplateRejectsUnlistedSheet(t *testing.T) {
+	const doc = `
+name: T
+requiredSheets: [Sheet1]
+sheets:
+  Sheet2:
+    requiredColumns: [Name]
+`
+	if _, err := LoadTemplate(strings.NewReader(doc), "yaml"); err == nil {
+		t.Fatal("expected an error for a sheet not listed in requiredSheets")
+	}
+}
+
+func TestLoadTemplateRejectsUnknownColumn(t *testing.T) {
+	const doc = `
+name: T
+requiredSheets: [Sheet1]
+sheets:
+  Sheet1:
+    requiredColumns: [Name]
+    columnTypes:
+      Ghost: number
+`
+	if _, err := LoadTemplate(strings.NewReader(doc), "yaml"); err == nil {
+		t.Fatal("expected an error for a columnTypes entry referring to an unlisted column")
+	}
+}
+
+func TestLoadTemplateRejectsInvalidRegexRule(t *testing.T) {
+	const doc = `
+name: T
+requiredSheets: [Sheet1]
+sheets:
+  Sheet1:
+    requiredColumns: [Name]
+    rules:
+      Name:
+        - regex: "["
+`
+	if _, err := LoadTemplate(strings.NewReader(doc), "yaml"); err == nil {
+		t.Fatal("expected an error for an invalid regex rule, not a panic")
+	}
+}
+
+func TestLoadTemplateFileUnrecognizedExtension(t *testing.T) {
+	if _, err := LoadTemplateFile("template.txt"); err == nil {
+		t.Fatal("expected an error for an unrecognized file extension")
+	}
+}
@@ -0,0 +1,238 @@
+package goxcel
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"sort"
+)
+
+// jsonError is ValidationResult's JSON representation of a ValidationError:
+// a stable RuleID alongside the human-readable fields, so CI tooling can
+// switch on RuleID without parsing Message.
+type jsonError struct {
+	RuleID   string `json:"ruleId"`
+	Type     string `json:"type"`
+	Message  string `json:"message"`
+	Sheet    string `json:"sheet,omitempty"`
+	Table    string `json:"table,omitempty"`
+	Column   string `json:"column,omitempty"`
+	Row      int    `json:"row,omitempty"`
+	Ref      string `json:"ref,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// MarshalJSON renders the result as a stable JSON document suitable for CI
+// consumption: {"valid": ..., "errors": [...], "sheetsValidated": [...],
+// "tablesValidated": [...]}. Each error carries a RuleID (see
+// ErrorType.RuleID) in addition to its human-readable Type and Message.
+func (r *ValidationResult) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Valid           bool        `json:"valid"`
+		Errors          []jsonError `json:"errors"`
+		SheetsValidated []string    `json:"sheetsValidated,omitempty"`
+		TablesValidated []string    `json:"tablesValidated,omitempty"`
+	}{
+		Valid:           r.Valid,
+		SheetsValidated: r.SheetsValidated,
+		TablesValidated: r.TablesValidated,
+	}
+	for _, e := range r.Errors {
+		out.Errors = append(out.Errors, jsonError{
+			RuleID:   e.Type.RuleID(),
+			Type:     e.Type.String(),
+			Message:  e.Message,
+			Sheet:    e.Sheet,
+			Table:    e.Table,
+			Column:   e.Column,
+			Row:      e.Row,
+			Ref:      e.Ref,
+			Expected: e.Expected,
+			Actual:   e.Actual,
+		})
+	}
+	return json.Marshal(out)
+}
+
+type junitFailure struct {
+	XMLName xml.Name `xml:"failure"`
+	Message string   `xml:"message,attr"`
+	Type    string   `xml:"type,attr"`
+	Body    string   `xml:",chardata"`
+}
+
+type junitCase struct {
+	XMLName   xml.Name `xml:"testcase"`
+	Name      string   `xml:"name,attr"`
+	ClassName string   `xml:"classname,attr"`
+	Failures  []junitFailure
+}
+
+type junitSuite struct {
+	XMLName  xml.Name `xml:"testsuite"`
+	Name     string   `xml:"name,attr"`
+	Tests    int      `xml:"tests,attr"`
+	Failures int      `xml:"failures,attr"`
+	Cases    []junitCase
+}
+
+// ToJUnit writes the result as a JUnit XML report: one <testcase> per
+// sheet (errors with no Sheet are grouped under "template"), each carrying
+// a <failure> per ValidationError, so CI systems that already render JUnit
+// output (GitHub Actions, GitLab, Jenkins) can surface goxcel failures the
+// same way they surface test failures.
+func (r *ValidationResult) ToJUnit(w io.Writer) error {
+	byGroup := make(map[string][]ValidationError)
+	for _, e := range r.Errors {
+		key := e.Sheet
+		if key == "" {
+			key = "template"
+		}
+		byGroup[key] = append(byGroup[key], e)
+	}
+
+	suite := junitSuite{Name: "goxcel"}
+	if len(byGroup) == 0 {
+		suite.Tests = 1
+		suite.Cases = append(suite.Cases, junitCase{Name: "validation", ClassName: "goxcel"})
+	} else {
+		for _, key := range sortedKeys(byGroup) {
+			errs := byGroup[key]
+			tc := junitCase{Name: key, ClassName: "goxcel"}
+			for _, e := range errs {
+				tc.Failures = append(tc.Failures, junitFailure{
+					Message: e.Message,
+					Type:    e.Type.RuleID(),
+					Body:    e.Message,
+				})
+			}
+			suite.Cases = append(suite.Cases, tc)
+			suite.Failures += len(errs)
+		}
+		suite.Tests = len(suite.Cases)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// ToSARIF writes the result as a SARIF 2.1.0 log, the format GitHub code
+// scanning (and other CI dashboards) ingest to annotate pull requests with
+// findings. Each distinct ErrorType present in the result becomes a rule
+// in the tool driver; each ValidationError becomes a result located at the
+// offending sheet and, for cell-level errors, row.
+func (r *ValidationResult) ToSARIF(w io.Writer) error {
+	seenRules := make(map[string]bool)
+	var rules []sarifRule
+	var results []sarifResult
+
+	for _, e := range r.Errors {
+		ruleID := e.Type.RuleID()
+		if !seenRules[ruleID] {
+			seenRules[ruleID] = true
+			rules = append(rules, sarifRule{ID: ruleID, ShortDescription: sarifMessage{Text: e.Type.String()}})
+		}
+
+		uri := e.Sheet
+		if uri == "" {
+			uri = "workbook"
+		}
+		var region *sarifRegion
+		if e.Row > 0 {
+			region = &sarifRegion{StartLine: e.Row}
+		}
+		results = append(results, sarifResult{
+			RuleID:  ruleID,
+			Level:   "error",
+			Message: sarifMessage{Text: e.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: uri},
+					Region:           region,
+				},
+			}},
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "goxcel",
+				InformationURI: "https://github.com/meddhiazoghlami/goxcel",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
@@ -0,0 +1,97 @@
+package goxcel
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func sampleResult() *ValidationResult {
+	r := &ValidationResult{Valid: true, SheetsValidated: []string{"Orders"}}
+	r.addError(ValidationError{
+		Type:     ErrorCellValue,
+		Message:  `row 2: column "Qty" value "-1" violates rule: between 0 and 100`,
+		Sheet:    "Orders",
+		Table:    "OrdersTable",
+		Column:   "Qty",
+		Row:      2,
+		Ref:      "B3",
+		Expected: "between 0 and 100",
+		Actual:   "-1",
+	})
+	return r
+}
+
+func TestValidationResultMarshalJSON(t *testing.T) {
+	data, err := sampleResult().MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var out struct {
+		Valid  bool `json:"valid"`
+		Errors []struct {
+			RuleID string `json:"ruleId"`
+			Ref    string `json:"ref"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("round-trip unmarshal: %v", err)
+	}
+	if len(out.Errors) != 1 {
+		t.Fatalf("got %d errors, want 1", len(out.Errors))
+	}
+	if out.Errors[0].RuleID != "goxcel/cell-value" {
+		t.Errorf("RuleID = %q, want goxcel/cell-value", out.Errors[0].RuleID)
+	}
+	if out.Errors[0].Ref != "B3" {
+		t.Errorf("Ref = %q, want B3", out.Errors[0].Ref)
+	}
+}
+
+func TestValidationResultToJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sampleResult().ToJUnit(&buf); err != nil {
+		t.Fatalf("ToJUnit: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `<testsuite`) || !strings.Contains(out, `name="Orders"`) {
+		t.Errorf("ToJUnit output missing expected testsuite/testcase: %s", out)
+	}
+	if !strings.Contains(out, `type="goxcel/cell-value"`) {
+		t.Errorf("ToJUnit output missing rule id on failure: %s", out)
+	}
+}
+
+func TestValidationResultToSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := sampleResult().ToSARIF(&buf); err != nil {
+		t.Fatalf("ToSARIF: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("round-trip unmarshal: %v", err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 {
+		t.Fatalf("unexpected SARIF shape: %+v", log)
+	}
+	if log.Runs[0].Results[0].RuleID != "goxcel/cell-value" {
+		t.Errorf("RuleID = %q, want goxcel/cell-value", log.Runs[0].Results[0].RuleID)
+	}
+	if len(log.Runs[0].Tool.Driver.Rules) != 1 {
+		t.Errorf("expected 1 rule in driver, got %d", len(log.Runs[0].Tool.Driver.Rules))
+	}
+}
+
+func TestValidationResultToJUnitNoErrors(t *testing.T) {
+	var buf bytes.Buffer
+	result := &ValidationResult{Valid: true}
+	if err := result.ToJUnit(&buf); err != nil {
+		t.Fatalf("ToJUnit: %v", err)
+	}
+	if !strings.Contains(buf.String(), `tests="1"`) {
+		t.Errorf("expected a single passing testcase, got: %s", buf.String())
+	}
+}
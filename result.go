@@ -0,0 +1,26 @@
+package goxcel
+
+import "fmt"
+
+// ValidationResult collects the outcome of validating a Workbook against a
+// Template or a set of required columns.
+type ValidationResult struct {
+	Valid           bool
+	Errors          []ValidationError
+	SheetsValidated []string
+	TablesValidated []string
+}
+
+// Summary returns a short one-line description of the result, suitable for
+// logging.
+func (r *ValidationResult) Summary() string {
+	if r.Valid {
+		return "valid"
+	}
+	return fmt.Sprintf("invalid: %d error(s)", len(r.Errors))
+}
+
+func (r *ValidationResult) addError(err ValidationError) {
+	r.Errors = append(r.Errors, err)
+	r.Valid = false
+}
@@ -0,0 +1,99 @@
+package goxcel
+
+// TypeStrictness controls how strictly ColumnType rules are enforced when a
+// column's data rows don't unanimously match the expected CellType.
+type TypeStrictness int
+
+const (
+	// TypeStrictnessStrict requires every non-empty cell in the column to
+	// match the expected type.
+	TypeStrictnessStrict TypeStrictness = iota
+	// TypeStrictnessLenient tolerates mismatches as long as at least half
+	// of the non-empty cells match the expected type.
+	TypeStrictnessLenient
+)
+
+// SheetSchema describes the expected shape of a single sheet: which
+// columns must/may be present, how many data rows are expected, and
+// per-column type or value constraints. Build a SheetSchema with
+// NewSchema rather than constructing it directly, unless you need full
+// control over the zero value (see examples/template_validation).
+type SheetSchema struct {
+	TableName         string
+	RequiredColumns   []string
+	OptionalColumns   []string
+	MinRows           int
+	MaxRows           int
+	AllowEmpty        bool
+	StrictColumnsMode bool
+	ExpectOrderMode   bool
+	ColumnTypes       map[string]CellType
+	Strictness        TypeStrictness
+	ValueRules        map[string][]ColumnRule
+	MaxCellErrorsN    int
+}
+
+// SchemaBuilder builds a SheetSchema with method chaining. Obtain one with
+// NewSchema.
+type SchemaBuilder struct {
+	schema SheetSchema
+}
+
+// NewSchema starts building a SheetSchema.
+func NewSchema() *SchemaBuilder {
+	return &SchemaBuilder{}
+}
+
+// RequireColumns marks the given column names as mandatory.
+func (b *SchemaBuilder) RequireColumns(cols ...string) *SchemaBuilder {
+	b.schema.RequiredColumns = append(b.schema.RequiredColumns, cols...)
+	return b
+}
+
+// OptionalColumns marks the given column names as allowed but not required.
+func (b *SchemaBuilder) OptionalColumns(cols ...string) *SchemaBuilder {
+	b.schema.OptionalColumns = append(b.schema.OptionalColumns, cols...)
+	return b
+}
+
+// RowCount sets the expected inclusive range of data rows (excluding the
+// header row).
+func (b *SchemaBuilder) RowCount(min, max int) *SchemaBuilder {
+	b.schema.MinRows = min
+	b.schema.MaxRows = max
+	return b
+}
+
+// StrictColumns fails validation if the table contains columns beyond
+// RequiredColumns and OptionalColumns.
+func (b *SchemaBuilder) StrictColumns() *SchemaBuilder {
+	b.schema.StrictColumnsMode = true
+	return b
+}
+
+// ColumnType declares the expected CellType for a column's data rows.
+func (b *SchemaBuilder) ColumnType(col string, t CellType) *SchemaBuilder {
+	if b.schema.ColumnTypes == nil {
+		b.schema.ColumnTypes = make(map[string]CellType)
+	}
+	b.schema.ColumnTypes[col] = t
+	return b
+}
+
+// TypeStrictness sets how strictly ColumnType rules are enforced.
+func (b *SchemaBuilder) TypeStrictness(s TypeStrictness) *SchemaBuilder {
+	b.schema.Strictness = s
+	return b
+}
+
+// ExpectOrder requires RequiredColumns to appear in the table in the same
+// order they were declared.
+func (b *SchemaBuilder) ExpectOrder() *SchemaBuilder {
+	b.schema.ExpectOrderMode = true
+	return b
+}
+
+// Build returns the assembled SheetSchema.
+func (b *SchemaBuilder) Build() SheetSchema {
+	return b.schema
+}
@@ -0,0 +1,471 @@
+package goxcel
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// StreamOption configures ValidateTemplateStream.
+type StreamOption func(*streamConfig)
+
+type streamConfig struct {
+	onError func(ValidationError) bool
+}
+
+// OnError registers a callback invoked for every ValidationError as it's
+// found, in row order. Returning false stops validation of the current
+// sheet early; the ValidationResult returned by ValidateTemplateStream
+// still contains every error collected up to that point.
+func OnError(fn func(ValidationError) bool) StreamOption {
+	return func(c *streamConfig) { c.onError = fn }
+}
+
+// ValidateTemplateStream validates the XLSX file at path against tmpl
+// without materializing the workbook into memory: sheet XML is parsed with
+// an xml.Decoder in token mode and rules are evaluated row by row. This
+// trades the convenience of ReadFile-then-ValidateTemplate for bounded
+// memory use on very large workbooks, mirroring the streaming reader
+// pattern excelize uses for big files.
+func ValidateTemplateStream(path string, tmpl Template, opts ...StreamOption) (*ValidationResult, error) {
+	cfg := &streamConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("goxcel: open %q: %w", path, err)
+	}
+	defer zr.Close()
+
+	shared, err := readSharedStrings(&zr.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sheetNames, err := readWorkbookSheetNames(&zr.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ValidationResult{Valid: true}
+	// report records a workbook-level error and passes it to OnError; no
+	// sheet is in progress here, so a false return has nothing to stop.
+	report := func(err ValidationError) {
+		result.addError(err)
+		if cfg.onError != nil {
+			cfg.onError(err)
+		}
+	}
+	present := make(map[string]bool, len(sheetNames))
+	for _, n := range sheetNames {
+		present[n] = true
+	}
+
+	required := make(map[string]bool, len(tmpl.RequiredSheets))
+	for _, name := range tmpl.RequiredSheets {
+		required[name] = true
+		if !present[name] {
+			report(ValidationError{
+				Type:    ErrorMissingSheet,
+				Message: fmt.Sprintf("required sheet %q not found", name),
+				Sheet:   name,
+			})
+			continue
+		}
+		result.SheetsValidated = append(result.SheetsValidated, name)
+	}
+
+	if tmpl.StrictSheetsMode {
+		for _, name := range sheetNames {
+			if !required[name] {
+				report(ValidationError{
+					Type:    ErrorUnexpectedSheet,
+					Message: fmt.Sprintf("unexpected sheet %q in strict mode", name),
+					Sheet:   name,
+				})
+			}
+		}
+	}
+
+	for i, name := range sheetNames {
+		schema, ok := tmpl.SheetSchemas[name]
+		if !ok {
+			continue
+		}
+		if err := validateSheetStream(&zr.Reader, i+1, name, schema, shared, result, cfg); err != nil {
+			return result, fmt.Errorf("goxcel: sheet %q: %w", name, err)
+		}
+	}
+
+	return result, nil
+}
+
+type columnTypeStats struct{ total, matched int }
+
+func validateSheetStream(zr *zip.Reader, sheetIndex int, sheetName string, schema SheetSchema, shared []string, result *ValidationResult, cfg *streamConfig) error {
+	refSets, err := collectRefColumnValues(zr, sheetIndex, shared, schema.ValueRules)
+	if err != nil {
+		return err
+	}
+
+	var headers []string
+	colIndex := make(map[string]int)
+	rowCount := 0
+	typeStats := make(map[string]*columnTypeStats, len(schema.ColumnTypes))
+	seen := make(map[string]map[string]int)
+	for col, rules := range schema.ValueRules {
+		for _, rule := range rules {
+			if _, ok := rule.(uniqueRule); ok {
+				seen[col] = make(map[string]int)
+			}
+		}
+	}
+
+	// onErrorStopped tracks only an explicit OnError-requested stop, which
+	// (per OnError's doc) ends validation of the whole sheet early.
+	onErrorStopped := false
+	// emit reports any non-cell-value error (missing/unexpected column,
+	// column order, row count, column type): it respects OnError but is
+	// never subject to MaxCellErrors, which cellrules.go documents as
+	// bounding only ErrorCellValue errors — matching ValidateTemplate's
+	// contract, where those checks always run in full.
+	emit := func(err ValidationError) bool {
+		result.addError(err)
+		if cfg.onError != nil && !cfg.onError(err) {
+			onErrorStopped = true
+			return false
+		}
+		return true
+	}
+
+	cellValueCount := 0
+	cellValueCapped := false
+	// emitCellValue reports an ErrorCellValue error and, in addition to
+	// OnError, enforces MaxCellErrors. Once capped, subsequent rows skip
+	// value-rule checking entirely (cellValueCapped), but row counting
+	// and column type stats (and the row-count/column-type checks run
+	// after the loop) keep going regardless, unless OnError itself asked
+	// to stop.
+	emitCellValue := func(err ValidationError) bool {
+		if !emit(err) {
+			return false
+		}
+		cellValueCount++
+		if schema.MaxCellErrorsN > 0 && cellValueCount >= schema.MaxCellErrorsN {
+			cellValueCapped = true
+			return false
+		}
+		return true
+	}
+
+	sawHeader := false
+	err = streamSheetRows(zr, sheetIndex, shared, func(cells []Cell) bool {
+		if !sawHeader {
+			sawHeader = true
+			headers = cellsToStrings(cells)
+			for i, h := range headers {
+				colIndex[h] = i
+			}
+			handleStreamHeader(sheetName, headers, schema, emit)
+			return !onErrorStopped
+		}
+
+		rowCount++
+		handleStreamRow(sheetName, rowCount, cells, colIndex, schema, typeStats, seen, refSets, emitCellValue, &cellValueCapped)
+		return !onErrorStopped
+	})
+	if err != nil {
+		return err
+	}
+	if onErrorStopped {
+		return nil
+	}
+
+	if !sawHeader {
+		emit(ValidationError{
+			Type:    ErrorMissingSheet,
+			Message: fmt.Sprintf("sheet %q has no header row to validate", sheetName),
+			Sheet:   sheetName,
+		})
+		return nil
+	}
+	result.TablesValidated = append(result.TablesValidated, sheetName)
+
+	if schema.MinRows > 0 && rowCount < schema.MinRows {
+		emit(ValidationError{
+			Type:     ErrorRowCount,
+			Message:  fmt.Sprintf("sheet %q: expected at least %d data row(s), found %d", sheetName, schema.MinRows, rowCount),
+			Sheet:    sheetName,
+			Expected: fmt.Sprintf(">= %d", schema.MinRows),
+			Actual:   fmt.Sprintf("%d", rowCount),
+		})
+	}
+	if schema.MaxRows > 0 && rowCount > schema.MaxRows {
+		emit(ValidationError{
+			Type:     ErrorRowCount,
+			Message:  fmt.Sprintf("sheet %q: expected at most %d data row(s), found %d", sheetName, schema.MaxRows, rowCount),
+			Sheet:    sheetName,
+			Expected: fmt.Sprintf("<= %d", schema.MaxRows),
+			Actual:   fmt.Sprintf("%d", rowCount),
+		})
+	}
+
+	for _, col := range sortedKeys(schema.ColumnTypes) {
+		st, ok := typeStats[col]
+		if !ok || st.total == 0 {
+			continue
+		}
+		threshold := st.total
+		if schema.Strictness == TypeStrictnessLenient {
+			threshold = (st.total + 1) / 2
+		}
+		if st.matched < threshold {
+			emit(ValidationError{
+				Type:     ErrorColumnType,
+				Message:  fmt.Sprintf("sheet %q: column %q does not match expected type %s", sheetName, col, schema.ColumnTypes[col]),
+				Sheet:    sheetName,
+				Column:   col,
+				Expected: schema.ColumnTypes[col].String(),
+				Actual:   fmt.Sprintf("%d/%d rows matched", st.matched, st.total),
+			})
+		}
+	}
+
+	return nil
+}
+
+func handleStreamHeader(sheetName string, headers []string, schema SheetSchema, emit func(ValidationError) bool) bool {
+	present := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		present[h] = true
+	}
+	for _, col := range schema.RequiredColumns {
+		if !present[col] {
+			if !emit(ValidationError{
+				Type:    ErrorMissingColumn,
+				Message: fmt.Sprintf("sheet %q: missing required column %q", sheetName, col),
+				Sheet:   sheetName,
+				Column:  col,
+			}) {
+				return false
+			}
+		}
+	}
+
+	if schema.StrictColumnsMode {
+		allowed := make(map[string]bool, len(schema.RequiredColumns)+len(schema.OptionalColumns))
+		for _, c := range schema.RequiredColumns {
+			allowed[c] = true
+		}
+		for _, c := range schema.OptionalColumns {
+			allowed[c] = true
+		}
+		for _, h := range headers {
+			if !allowed[h] {
+				if !emit(ValidationError{
+					Type:    ErrorUnexpectedColumn,
+					Message: fmt.Sprintf("sheet %q: unexpected column %q in strict mode", sheetName, h),
+					Sheet:   sheetName,
+					Column:  h,
+				}) {
+					return false
+				}
+			}
+		}
+	}
+
+	if schema.ExpectOrderMode {
+		dummy := &ValidationResult{Valid: true}
+		validateColumnOrder(sheetName, "", headers, schema.RequiredColumns, dummy)
+		for _, err := range dummy.Errors {
+			if !emit(err) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func handleStreamRow(sheetName string, rowIdx int, cells []Cell, colIndex map[string]int, schema SheetSchema, typeStats map[string]*columnTypeStats, seen map[string]map[string]int, refSets map[string]map[string]bool, emitCellValue func(ValidationError) bool, cellValueCapped *bool) bool {
+	for col, expected := range schema.ColumnTypes {
+		idx, ok := colIndex[col]
+		if !ok || idx >= len(cells) || cells[idx].Type == CellTypeEmpty {
+			continue
+		}
+		st, ok := typeStats[col]
+		if !ok {
+			st = &columnTypeStats{}
+			typeStats[col] = st
+		}
+		st.total++
+		if cells[idx].Type == expected {
+			st.matched++
+		}
+	}
+
+	if *cellValueCapped {
+		return true
+	}
+
+	for _, col := range sortedKeys(schema.ValueRules) {
+		rules := schema.ValueRules[col]
+		idx, ok := colIndex[col]
+		if !ok || idx >= len(cells) {
+			continue
+		}
+		value := cells[idx].Value
+		for _, rule := range rules {
+			ok, descriptor := checkStreamRule(rule, value, seen[col], refSets, rowIdx)
+			if ok {
+				continue
+			}
+			if !emitCellValue(ValidationError{
+				Type:     ErrorCellValue,
+				Message:  fmt.Sprintf("row %d: column %q value %q violates rule: %s", rowIdx, col, value, descriptor),
+				Sheet:    sheetName,
+				Column:   col,
+				Row:      rowIdx,
+				Ref:      a1Ref(idx, rowIdx),
+				Expected: descriptor,
+				Actual:   value,
+			}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// checkStreamRule evaluates the subset of ColumnRule kinds that can be
+// checked without access to the full in-memory Table: refInRule is
+// resolved against refSets (precomputed by collectRefColumnValues) rather
+// than scanning sibling rows directly.
+func checkStreamRule(rule ColumnRule, value string, uniqueSeen map[string]int, refSets map[string]map[string]bool, rowIdx int) (bool, string) {
+	if rin, ok := rule.(refInRule); ok {
+		if value == "" {
+			return true, ""
+		}
+		if refSets[rin.otherColumn][value] {
+			return true, ""
+		}
+		return false, rin.describe()
+	}
+	return checkRule(rule, value, nil, nil, uniqueSeen, rowIdx)
+}
+
+// collectRefColumnValues runs a lightweight first pass over the sheet,
+// collecting only the values of columns referenced by ColumnRefIn rules,
+// so cross-column checks don't require buffering the entire table.
+func collectRefColumnValues(zr *zip.Reader, sheetIndex int, shared []string, rules map[string][]ColumnRule) (map[string]map[string]bool, error) {
+	otherCols := make(map[string]bool)
+	for _, colRules := range rules {
+		for _, rule := range colRules {
+			if rin, ok := rule.(refInRule); ok {
+				otherCols[rin.otherColumn] = true
+			}
+		}
+	}
+	if len(otherCols) == 0 {
+		return nil, nil
+	}
+
+	sets := make(map[string]map[string]bool, len(otherCols))
+	for col := range otherCols {
+		sets[col] = make(map[string]bool)
+	}
+
+	var headers []string
+	colIndex := make(map[string]int)
+	first := true
+	err := streamSheetRows(zr, sheetIndex, shared, func(cells []Cell) bool {
+		if first {
+			first = false
+			headers = cellsToStrings(cells)
+			for i, h := range headers {
+				colIndex[h] = i
+			}
+			return true
+		}
+		for col := range otherCols {
+			idx, ok := colIndex[col]
+			if !ok || idx >= len(cells) {
+				continue
+			}
+			if v := cells[idx].Value; v != "" {
+				sets[col][v] = true
+			}
+		}
+		return true
+	})
+	return sets, err
+}
+
+// streamSheetRows decodes xl/worksheets/sheetN.xml token by token, invoking
+// handle once per <row> with that row's cells. It never buffers more than
+// one row at a time. handle returns false to stop decoding early.
+func streamSheetRows(zr *zip.Reader, sheetIndex int, shared []string, handle func([]Cell) bool) error {
+	f := findZipFile(zr, fmt.Sprintf("xl/worksheets/sheet%d.xml", sheetIndex))
+	if f == nil {
+		return fmt.Errorf("worksheet %d not found", sheetIndex)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	dec := xml.NewDecoder(rc)
+	var current []Cell
+	inSheetData := false
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch el := tok.(type) {
+		case xml.StartElement:
+			switch el.Name.Local {
+			case "sheetData":
+				inSheetData = true
+			case "row":
+				if inSheetData {
+					current = nil
+				}
+			case "c":
+				if !inSheetData {
+					continue
+				}
+				var cellType string
+				for _, attr := range el.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+				var cellElem struct {
+					V string `xml:"v"`
+				}
+				if err := dec.DecodeElement(&cellElem, &el); err != nil {
+					return err
+				}
+				current = append(current, parseCell(cellType, cellElem.V, shared))
+			}
+		case xml.EndElement:
+			switch el.Name.Local {
+			case "row":
+				if inSheetData && !handle(current) {
+					return nil
+				}
+			case "sheetData":
+				inSheetData = false
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package goxcel
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+)
+
+// writeBenchWorkbook writes a minimal single-sheet XLSX file with the given
+// number of data rows under columns ID, Email, Age, and returns its path.
+func writeBenchWorkbook(tb testing.TB, rows int) string {
+	tb.Helper()
+
+	f, err := os.CreateTemp(tb.TempDir(), "bench-*.xlsx")
+	if err != nil {
+		tb.Fatalf("create temp file: %v", err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+
+	write := func(name, content string) {
+		w, err := zw.Create(name)
+		if err != nil {
+			tb.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			tb.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	write("[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+</Types>`)
+
+	write("_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`)
+
+	write("xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"/></sheets>
+</workbook>`)
+
+	var body string
+	body += `<row r="1"><c r="A1" t="str"><v>ID</v></c><c r="B1" t="str"><v>Email</v></c><c r="C1" t="str"><v>Age</v></c></row>`
+	for i := 1; i <= rows; i++ {
+		body += fmt.Sprintf(
+			`<row r="%d"><c r="A%d"><v>%d</v></c><c r="B%d" t="str"><v>user%d@example.com</v></c><c r="C%d"><v>%d</v></c></row>`,
+			i+1, i+1, i, i+1, i, i+1, 20+(i%50))
+	}
+	write("xl/worksheets/sheet1.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`+body+`</sheetData></worksheet>`)
+
+	if err := zw.Close(); err != nil {
+		tb.Fatalf("close zip: %v", err)
+	}
+	return f.Name()
+}
+
+func benchTemplate() Template {
+	schema := NewSchema().
+		RequireColumns("ID", "Email", "Age").
+		ColumnType("Age", CellTypeNumber).
+		ColumnRange("Age", 0, 130).
+		Build()
+	return NewTemplate("Bench").RequireSheets("Sheet1").Sheet("Sheet1", schema).Build()
+}
+
+// BenchmarkValidateTemplate_ReadFile measures the convenience path that
+// loads the full workbook into memory before validating it.
+func BenchmarkValidateTemplate_ReadFile(b *testing.B) {
+	path := writeBenchWorkbook(b, 5000)
+	tmpl := benchTemplate()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		wb, err := ReadFile(path)
+		if err != nil {
+			b.Fatalf("ReadFile: %v", err)
+		}
+		ValidateTemplate(wb, tmpl)
+	}
+}
+
+// BenchmarkValidateTemplateStream measures the streaming path, which never
+// holds more than one row in memory at a time.
+func BenchmarkValidateTemplateStream(b *testing.B) {
+	path := writeBenchWorkbook(b, 5000)
+	tmpl := benchTemplate()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ValidateTemplateStream(path, tmpl); err != nil {
+			b.Fatalf("ValidateTemplateStream: %v", err)
+		}
+	}
+}
+
+// TestStreamMemoryBounded shows that ValidateTemplateStream doesn't retain
+// the parsed rows the way ReadFile does: after validating a large workbook
+// and forcing a GC, the ReadFile path's retained heap (holding every Cell
+// in the workbook) dwarfs the streaming path's, which only ever keeps one
+// row, plus whatever small per-column state the rules need, alive at once.
+func TestStreamMemoryBounded(t *testing.T) {
+	tmpl := benchTemplate()
+	large := writeBenchWorkbook(t, 20000)
+
+	heapDelta := func(fn func()) uint64 {
+		runtime.GC()
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		fn()
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		return after.HeapAlloc - before.HeapAlloc
+	}
+
+	var retained *Workbook
+	readFileHeap := heapDelta(func() {
+		wb, err := ReadFile(large)
+		if err != nil {
+			t.Fatalf("ReadFile: %v", err)
+		}
+		ValidateTemplate(wb, tmpl)
+		retained = wb // keep the workbook alive so GC can't reclaim it
+	})
+
+	streamHeap := heapDelta(func() {
+		if _, err := ValidateTemplateStream(large, tmpl); err != nil {
+			t.Fatalf("ValidateTemplateStream: %v", err)
+		}
+	})
+
+	if readFileHeap <= streamHeap*3 {
+		t.Errorf("expected ReadFile to retain much more heap than streaming: readFile=%d stream=%d", readFileHeap, streamHeap)
+	}
+	runtime.KeepAlive(retained)
+}
@@ -0,0 +1,111 @@
+package goxcel
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestValidateTemplateStreamMaxCellErrorsScopedToCellValue verifies that
+// MaxCellErrors only bounds ErrorCellValue errors, matching
+// ValidateTemplate's contract (see cellrules.go's MaxCellErrors doc):
+// missing-column and row-count errors must still be reported in full even
+// after the cell-value cap has been hit.
+func TestValidateTemplateStreamMaxCellErrorsScopedToCellValue(t *testing.T) {
+	wb := &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Orders",
+				Tables: []Table{
+					{
+						Headers: []string{"X"},
+						Rows: [][]Cell{
+							{{Value: "bad", Type: CellTypeString}},
+							{{Value: "worse", Type: CellTypeString}},
+						},
+					},
+				},
+			},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "stream.xlsx")
+	if err := WriteFile(path, wb); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	schema := NewSchema().
+		RequireColumns("ID", "Quantity").
+		RowCount(5, 0).
+		ColumnRegex("X", "^[0-9]+$").
+		MaxCellErrors(1).
+		Build()
+	tmpl := NewTemplate("orders").RequireSheets("Orders").Sheet("Orders", schema).Build()
+
+	result, err := ValidateTemplateStream(path, tmpl)
+	if err != nil {
+		t.Fatalf("ValidateTemplateStream: %v", err)
+	}
+
+	counts := map[ErrorType]int{}
+	for _, e := range result.Errors {
+		counts[e.Type]++
+	}
+	if counts[ErrorMissingColumn] != 2 {
+		t.Errorf("ErrorMissingColumn count = %d, want 2 (unaffected by MaxCellErrors)", counts[ErrorMissingColumn])
+	}
+	if counts[ErrorRowCount] != 1 {
+		t.Errorf("ErrorRowCount count = %d, want 1 (unaffected by MaxCellErrors)", counts[ErrorRowCount])
+	}
+	if counts[ErrorCellValue] != 1 {
+		t.Errorf("ErrorCellValue count = %d, want 1 (capped by MaxCellErrors(1))", counts[ErrorCellValue])
+	}
+}
+
+// TestValidateTemplateStreamDeterministicTruncation verifies that, within
+// a single row with violations in more than one column, MaxCellErrors
+// caps by sorted column order every time rather than Go map iteration
+// order (see cellrules.go's evaluateValueRules, which fixed the same
+// class of bug for the non-streaming path).
+func TestValidateTemplateStreamDeterministicTruncation(t *testing.T) {
+	wb := &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Orders",
+				Tables: []Table{
+					{
+						Headers: []string{"A", "B", "C", "D", "E"},
+						Rows: [][]Cell{
+							{{Value: "x"}, {Value: "x"}, {Value: "x"}, {Value: "x"}, {Value: "x"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "stream.xlsx")
+	if err := WriteFile(path, wb); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	schema := NewSchema().
+		ColumnRegex("A", "^y$").
+		ColumnRegex("B", "^y$").
+		ColumnRegex("C", "^y$").
+		ColumnRegex("D", "^y$").
+		ColumnRegex("E", "^y$").
+		MaxCellErrors(1).
+		Build()
+	tmpl := NewTemplate("orders").RequireSheets("Orders").Sheet("Orders", schema).Build()
+
+	for i := 0; i < 10; i++ {
+		result, err := ValidateTemplateStream(path, tmpl)
+		if err != nil {
+			t.Fatalf("run %d: ValidateTemplateStream: %v", i, err)
+		}
+		if len(result.Errors) != 1 {
+			t.Fatalf("run %d: got %d errors, want 1", i, len(result.Errors))
+		}
+		if result.Errors[0].Column != "A" {
+			t.Fatalf("run %d: capped error column = %q, want A", i, result.Errors[0].Column)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package goxcel
+
+// Template describes the full set of expectations a workbook must satisfy:
+// which sheets must be present, whether extra sheets are allowed, and the
+// SheetSchema to validate each named sheet against.
+type Template struct {
+	Name             string
+	RequiredSheets   []string
+	StrictSheetsMode bool
+	SheetSchemas     map[string]SheetSchema
+}
+
+// TemplateBuilder builds a Template with method chaining. Obtain one with
+// NewTemplate.
+type TemplateBuilder struct {
+	tmpl Template
+}
+
+// NewTemplate starts building a Template with the given name. The name is
+// only used for diagnostics (e.g. in logs); it isn't matched against
+// anything in the workbook.
+func NewTemplate(name string) *TemplateBuilder {
+	return &TemplateBuilder{tmpl: Template{Name: name}}
+}
+
+// RequireSheets marks the given sheet names as mandatory.
+func (b *TemplateBuilder) RequireSheets(names ...string) *TemplateBuilder {
+	b.tmpl.RequiredSheets = append(b.tmpl.RequiredSheets, names...)
+	return b
+}
+
+// StrictSheets fails validation if the workbook contains sheets beyond
+// RequiredSheets.
+func (b *TemplateBuilder) StrictSheets() *TemplateBuilder {
+	b.tmpl.StrictSheetsMode = true
+	return b
+}
+
+// Sheet attaches a SheetSchema to validate the named sheet against.
+func (b *TemplateBuilder) Sheet(name string, schema SheetSchema) *TemplateBuilder {
+	if b.tmpl.SheetSchemas == nil {
+		b.tmpl.SheetSchemas = make(map[string]SheetSchema)
+	}
+	b.tmpl.SheetSchemas[name] = schema
+	return b
+}
+
+// Build returns the assembled Template.
+func (b *TemplateBuilder) Build() Template {
+	return b.tmpl
+}
@@ -0,0 +1,287 @@
+package goxcel
+
+import "fmt"
+
+// QuickValidate checks that the first table of the first sheet contains all
+// of the given columns. It's a convenience wrapper around ValidateColumns
+// for callers that don't need a full Template.
+func QuickValidate(workbook *Workbook, columns ...string) *ValidationResult {
+	result := &ValidationResult{Valid: true}
+
+	if len(workbook.Sheets) == 0 || len(workbook.Sheets[0].Tables) == 0 {
+		result.addError(ValidationError{
+			Type:    ErrorMissingSheet,
+			Message: "workbook has no sheets with tables",
+		})
+		return result
+	}
+
+	table := &workbook.Sheets[0].Tables[0]
+	for _, col := range ValidateColumns(table, columns...) {
+		result.addError(ValidationError{
+			Type:    ErrorMissingColumn,
+			Message: fmt.Sprintf("missing required column %q", col),
+			Column:  col,
+		})
+	}
+	return result
+}
+
+// ValidateColumns returns the subset of columns not present in table's
+// headers.
+func ValidateColumns(table *Table, columns ...string) []string {
+	present := make(map[string]bool, len(table.Headers))
+	for _, h := range table.Headers {
+		present[h] = true
+	}
+
+	var missing []string
+	for _, col := range columns {
+		if !present[col] {
+			missing = append(missing, col)
+		}
+	}
+	return missing
+}
+
+// ValidateTemplate validates workbook against tmpl: required/strict sheets,
+// then for every sheet with an attached SheetSchema, required/optional/
+// strict columns, column order, row counts, column types, and per-column
+// value rules.
+func ValidateTemplate(workbook *Workbook, tmpl Template) *ValidationResult {
+	result := &ValidationResult{Valid: true}
+
+	bySheet := make(map[string]*Sheet, len(workbook.Sheets))
+	for i := range workbook.Sheets {
+		bySheet[workbook.Sheets[i].Name] = &workbook.Sheets[i]
+	}
+
+	required := make(map[string]bool, len(tmpl.RequiredSheets))
+	for _, name := range tmpl.RequiredSheets {
+		required[name] = true
+		if _, ok := bySheet[name]; !ok {
+			result.addError(ValidationError{
+				Type:    ErrorMissingSheet,
+				Message: fmt.Sprintf("required sheet %q not found", name),
+				Sheet:   name,
+			})
+			continue
+		}
+		result.SheetsValidated = append(result.SheetsValidated, name)
+	}
+
+	if tmpl.StrictSheetsMode {
+		for _, sheet := range workbook.Sheets {
+			if !required[sheet.Name] {
+				result.addError(ValidationError{
+					Type:    ErrorUnexpectedSheet,
+					Message: fmt.Sprintf("unexpected sheet %q in strict mode", sheet.Name),
+					Sheet:   sheet.Name,
+				})
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(tmpl.SheetSchemas) {
+		schema := tmpl.SheetSchemas[name]
+		sheet, ok := bySheet[name]
+		if !ok {
+			continue // already reported above if it was also required
+		}
+		validateSheetSchema(sheet, schema, result)
+	}
+
+	return result
+}
+
+func validateSheetSchema(sheet *Sheet, schema SheetSchema, result *ValidationResult) {
+	validateSheetCore(sheet, schema, allowedColumns(schema), result)
+}
+
+// allowedColumns returns the set of column names a schema's RequiredColumns
+// and OptionalColumns permit. CompileTemplate precomputes this once per
+// sheet instead of rebuilding it on every Validate call.
+func allowedColumns(schema SheetSchema) map[string]bool {
+	allowed := make(map[string]bool, len(schema.RequiredColumns)+len(schema.OptionalColumns))
+	for _, col := range schema.RequiredColumns {
+		allowed[col] = true
+	}
+	for _, col := range schema.OptionalColumns {
+		allowed[col] = true
+	}
+	return allowed
+}
+
+func validateSheetCore(sheet *Sheet, schema SheetSchema, allowed map[string]bool, result *ValidationResult) {
+	table := findTable(sheet, schema.TableName)
+	if table == nil {
+		result.addError(ValidationError{
+			Type:    ErrorMissingSheet,
+			Message: fmt.Sprintf("sheet %q has no table to validate", sheet.Name),
+			Sheet:   sheet.Name,
+		})
+		return
+	}
+	result.TablesValidated = append(result.TablesValidated, table.Name)
+
+	for _, col := range ValidateColumns(table, schema.RequiredColumns...) {
+		result.addError(ValidationError{
+			Type:    ErrorMissingColumn,
+			Message: fmt.Sprintf("sheet %q: missing required column %q", sheet.Name, col),
+			Sheet:   sheet.Name,
+			Table:   table.Name,
+			Column:  col,
+		})
+	}
+
+	if schema.StrictColumnsMode {
+		for _, h := range table.Headers {
+			if !allowed[h] {
+				result.addError(ValidationError{
+					Type:    ErrorUnexpectedColumn,
+					Message: fmt.Sprintf("sheet %q: unexpected column %q in strict mode", sheet.Name, h),
+					Sheet:   sheet.Name,
+					Table:   table.Name,
+					Column:  h,
+				})
+			}
+		}
+	}
+
+	if schema.ExpectOrderMode {
+		validateColumnOrder(sheet.Name, table.Name, table.Headers, schema.RequiredColumns, result)
+	}
+
+	if schema.MinRows > 0 || schema.MaxRows > 0 {
+		validateRowCount(sheet.Name, table, schema, result)
+	}
+
+	for _, col := range sortedKeys(schema.ColumnTypes) {
+		validateColumnType(sheet.Name, table, col, schema.ColumnTypes[col], schema.Strictness, result)
+	}
+
+	for _, err := range evaluateValueRules(sheet.Name, table.Name, table, schema.ValueRules, schema.MaxCellErrorsN) {
+		result.addError(err)
+	}
+}
+
+func findTable(sheet *Sheet, name string) *Table {
+	if len(sheet.Tables) == 0 {
+		return nil
+	}
+	if name == "" {
+		return &sheet.Tables[0]
+	}
+	for i := range sheet.Tables {
+		if sheet.Tables[i].Name == name {
+			return &sheet.Tables[i]
+		}
+	}
+	return nil
+}
+
+func validateColumnOrder(sheetName, tableName string, headers, required []string, result *ValidationResult) {
+	positions := make(map[string]int, len(headers))
+	for i, h := range headers {
+		positions[h] = i
+	}
+
+	last := -1
+	for _, col := range required {
+		pos, ok := positions[col]
+		if !ok {
+			continue // already reported as missing
+		}
+		if pos < last {
+			result.addError(ValidationError{
+				Type:    ErrorColumnOrder,
+				Message: fmt.Sprintf("sheet %q: column %q is out of the expected order", sheetName, col),
+				Sheet:   sheetName,
+				Table:   tableName,
+				Column:  col,
+			})
+		}
+		last = pos
+	}
+}
+
+func validateRowCount(sheetName string, table *Table, schema SheetSchema, result *ValidationResult) {
+	n := len(table.Rows)
+	if schema.MinRows > 0 && n < schema.MinRows {
+		result.addError(ValidationError{
+			Type:     ErrorRowCount,
+			Message:  fmt.Sprintf("sheet %q: expected at least %d data row(s), found %d", sheetName, schema.MinRows, n),
+			Sheet:    sheetName,
+			Table:    table.Name,
+			Expected: fmt.Sprintf(">= %d", schema.MinRows),
+			Actual:   fmt.Sprintf("%d", n),
+		})
+	}
+	if schema.MaxRows > 0 && n > schema.MaxRows {
+		result.addError(ValidationError{
+			Type:     ErrorRowCount,
+			Message:  fmt.Sprintf("sheet %q: expected at most %d data row(s), found %d", sheetName, schema.MaxRows, n),
+			Sheet:    sheetName,
+			Table:    table.Name,
+			Expected: fmt.Sprintf("<= %d", schema.MaxRows),
+			Actual:   fmt.Sprintf("%d", n),
+		})
+	}
+}
+
+func validateColumnType(sheetName string, table *Table, col string, expected CellType, strictness TypeStrictness, result *ValidationResult) {
+	idx := -1
+	for i, h := range table.Headers {
+		if h == col {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return // already reported as missing
+	}
+
+	var total, matched int
+	for _, row := range table.Rows {
+		if idx >= len(row) || row[idx].Type == CellTypeEmpty {
+			continue
+		}
+		total++
+		if row[idx].Type == expected {
+			matched++
+		}
+	}
+	if total == 0 {
+		return
+	}
+
+	threshold := total
+	if strictness == TypeStrictnessLenient {
+		threshold = (total + 1) / 2
+	}
+	if matched < threshold {
+		result.addError(ValidationError{
+			Type:     ErrorColumnType,
+			Message:  fmt.Sprintf("sheet %q: column %q does not match expected type %s", sheetName, col, expected),
+			Sheet:    sheetName,
+			Table:    table.Name,
+			Column:   col,
+			Expected: expected.String(),
+			Actual:   fmt.Sprintf("%d/%d rows matched", matched, total),
+		})
+	}
+}
+
+// ErrorsByColumn groups the result's errors by their Column field, omitting
+// errors that aren't column-specific (empty Column). Useful for rendering
+// row-level reports per offending column.
+func (r *ValidationResult) ErrorsByColumn() map[string][]ValidationError {
+	grouped := make(map[string][]ValidationError)
+	for _, err := range r.Errors {
+		if err.Column == "" {
+			continue
+		}
+		grouped[err.Column] = append(grouped[err.Column], err)
+	}
+	return grouped
+}
@@ -0,0 +1,74 @@
+package goxcel
+
+import "testing"
+
+func validateTestWorkbook() *Workbook {
+	return &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Orders",
+				Tables: []Table{
+					{
+						Headers: []string{"ID", "Quantity"},
+						Rows: [][]Cell{
+							{{Value: "1", Type: CellTypeNumber}, {Value: "5", Type: CellTypeNumber}},
+							{{Value: "2", Type: CellTypeNumber}, {Value: "7", Type: CellTypeNumber}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateTemplateValid(t *testing.T) {
+	schema := NewSchema().RequireColumns("ID", "Quantity").ColumnType("Quantity", CellTypeNumber).Build()
+	tmpl := NewTemplate("orders").RequireSheets("Orders").Sheet("Orders", schema).Build()
+
+	result := ValidateTemplate(validateTestWorkbook(), tmpl)
+	if !result.Valid {
+		t.Fatalf("expected valid, got errors: %+v", result.Errors)
+	}
+	if len(result.SheetsValidated) != 1 || result.SheetsValidated[0] != "Orders" {
+		t.Errorf("SheetsValidated = %v, want [Orders]", result.SheetsValidated)
+	}
+}
+
+func TestValidateTemplateMissingSheet(t *testing.T) {
+	tmpl := NewTemplate("orders").RequireSheets("Invoices").Build()
+
+	result := ValidateTemplate(validateTestWorkbook(), tmpl)
+	if result.Valid {
+		t.Fatal("expected invalid result for missing required sheet")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Type != ErrorMissingSheet {
+		t.Errorf("unexpected errors: %+v", result.Errors)
+	}
+}
+
+func TestValidateTemplateMissingColumn(t *testing.T) {
+	schema := NewSchema().RequireColumns("ID", "Quantity", "Status").Build()
+	tmpl := NewTemplate("orders").RequireSheets("Orders").Sheet("Orders", schema).Build()
+
+	result := ValidateTemplate(validateTestWorkbook(), tmpl)
+	if result.Valid {
+		t.Fatal("expected invalid result for missing column")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Type != ErrorMissingColumn || result.Errors[0].Column != "Status" {
+		t.Errorf("unexpected errors: %+v", result.Errors)
+	}
+}
+
+func TestValidateTemplateStrictSheets(t *testing.T) {
+	tmpl := NewTemplate("orders").RequireSheets("Orders").StrictSheets().Build()
+	wb := validateTestWorkbook()
+	wb.Sheets = append(wb.Sheets, Sheet{Name: "Extra", Tables: []Table{{Headers: []string{"X"}}}})
+
+	result := ValidateTemplate(wb, tmpl)
+	if result.Valid {
+		t.Fatal("expected invalid result for unexpected sheet in strict mode")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Type != ErrorUnexpectedSheet {
+		t.Errorf("unexpected errors: %+v", result.Errors)
+	}
+}
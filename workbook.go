@@ -0,0 +1,285 @@
+package goxcel
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CellType classifies the value stored in a Cell.
+type CellType int
+
+const (
+	CellTypeEmpty CellType = iota
+	CellTypeString
+	CellTypeNumber
+	CellTypeBool
+	CellTypeDate
+)
+
+// String returns a human-readable name for the cell type, used in
+// validation messages (e.g. "expected: number, actual: string").
+func (t CellType) String() string {
+	switch t {
+	case CellTypeString:
+		return "string"
+	case CellTypeNumber:
+		return "number"
+	case CellTypeBool:
+		return "bool"
+	case CellTypeDate:
+		return "date"
+	default:
+		return "empty"
+	}
+}
+
+// Cell is a single parsed spreadsheet value along with its detected type.
+type Cell struct {
+	Value string
+	Type  CellType
+}
+
+// Table is a contiguous block of rows within a Sheet: a header row followed
+// by data rows. Most sheets contain a single table; Name is left empty when
+// it wasn't explicitly labeled.
+type Table struct {
+	Name    string
+	Headers []string
+	Rows    [][]Cell
+}
+
+// Sheet is one worksheet of a Workbook, broken into the Tables detected
+// within it.
+type Sheet struct {
+	Name   string
+	Tables []Table
+}
+
+// Workbook is the in-memory representation of a parsed XLSX file.
+type Workbook struct {
+	Sheets []Sheet
+}
+
+// ReadFile opens the XLSX archive at path and parses every worksheet into a
+// Workbook, loading the whole file into memory. For very large files, see
+// ValidateTemplateStream, which validates without materializing rows.
+func ReadFile(path string) (*Workbook, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("goxcel: open %q: %w", path, err)
+	}
+	defer zr.Close()
+
+	shared, err := readSharedStrings(&zr.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetNames, err := readWorkbookSheetNames(&zr.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	wb := &Workbook{}
+	for i, name := range sheetNames {
+		rows, err := readSheetRows(&zr.Reader, i+1, shared)
+		if err != nil {
+			return nil, fmt.Errorf("goxcel: sheet %q: %w", name, err)
+		}
+		wb.Sheets = append(wb.Sheets, Sheet{
+			Name:   name,
+			Tables: rowsToTables(rows),
+		})
+	}
+	return wb, nil
+}
+
+// rowsToTables groups raw sheet rows into tables, treating the first row as
+// headers and splitting on fully blank rows so multiple tables in the same
+// sheet are detected separately.
+func rowsToTables(rows [][]Cell) []Table {
+	var tables []Table
+	var current *Table
+
+	for _, row := range rows {
+		if isBlankRow(row) {
+			current = nil
+			continue
+		}
+		if current == nil {
+			tables = append(tables, Table{Headers: cellsToStrings(row)})
+			current = &tables[len(tables)-1]
+			continue
+		}
+		current.Rows = append(current.Rows, row)
+	}
+	return tables
+}
+
+func isBlankRow(row []Cell) bool {
+	for _, c := range row {
+		if strings.TrimSpace(c.Value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func cellsToStrings(cells []Cell) []string {
+	out := make([]string, len(cells))
+	for i, c := range cells {
+		out[i] = c.Value
+	}
+	return out
+}
+
+func readSharedStrings(zr *zip.Reader) ([]string, error) {
+	f := findZipFile(zr, "xl/sharedStrings.xml")
+	if f == nil {
+		return nil, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("goxcel: open sharedStrings.xml: %w", err)
+	}
+	defer rc.Close()
+
+	var sst struct {
+		Items []struct {
+			T   string `xml:"t"`
+			Run []struct {
+				T string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := xml.NewDecoder(rc).Decode(&sst); err != nil {
+		return nil, fmt.Errorf("goxcel: parse sharedStrings.xml: %w", err)
+	}
+
+	out := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		if item.T != "" {
+			out[i] = item.T
+			continue
+		}
+		var b strings.Builder
+		for _, r := range item.Run {
+			b.WriteString(r.T)
+		}
+		out[i] = b.String()
+	}
+	return out, nil
+}
+
+func readWorkbookSheetNames(zr *zip.Reader) ([]string, error) {
+	f := findZipFile(zr, "xl/workbook.xml")
+	if f == nil {
+		return nil, fmt.Errorf("goxcel: xl/workbook.xml not found")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("goxcel: open workbook.xml: %w", err)
+	}
+	defer rc.Close()
+
+	var wb struct {
+		Sheets struct {
+			Sheet []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"sheet"`
+		} `xml:"sheets"`
+	}
+	if err := xml.NewDecoder(rc).Decode(&wb); err != nil {
+		return nil, fmt.Errorf("goxcel: parse workbook.xml: %w", err)
+	}
+
+	names := make([]string, len(wb.Sheets.Sheet))
+	for i, s := range wb.Sheets.Sheet {
+		names[i] = s.Name
+	}
+	return names, nil
+}
+
+func readSheetRows(zr *zip.Reader, index int, shared []string) ([][]Cell, error) {
+	f := findZipFile(zr, fmt.Sprintf("xl/worksheets/sheet%d.xml", index))
+	if f == nil {
+		return nil, fmt.Errorf("worksheet %d not found", index)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var sheet struct {
+		SheetData struct {
+			Row []struct {
+				C []struct {
+					R string `xml:"r,attr"`
+					T string `xml:"t,attr"`
+					V string `xml:"v"`
+				} `xml:"c"`
+			} `xml:"row"`
+		} `xml:"sheetData"`
+	}
+	if err := xml.NewDecoder(rc).Decode(&sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]Cell, 0, len(sheet.SheetData.Row))
+	for _, r := range sheet.SheetData.Row {
+		row := make([]Cell, len(r.C))
+		for i, c := range r.C {
+			row[i] = parseCell(c.T, c.V, shared)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseCell(t, v string, shared []string) Cell {
+	switch t {
+	case "s":
+		idx, err := strconv.Atoi(v)
+		if err != nil || idx < 0 || idx >= len(shared) {
+			return Cell{Value: v, Type: CellTypeString}
+		}
+		return Cell{Value: shared[idx], Type: CellTypeString}
+	case "b":
+		return Cell{Value: v, Type: CellTypeBool}
+	case "str", "inlineStr":
+		return Cell{Value: v, Type: CellTypeString}
+	default:
+		if v == "" {
+			return Cell{Value: v, Type: CellTypeEmpty}
+		}
+		if _, err := strconv.ParseFloat(v, 64); err == nil {
+			return Cell{Value: v, Type: CellTypeNumber}
+		}
+		return Cell{Value: v, Type: CellTypeString}
+	}
+}
+
+func findZipFile(zr *zip.Reader, name string) *zip.File {
+	for _, f := range zr.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of m sorted lexically, used wherever map
+// iteration order would otherwise make validation output non-deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,168 @@
+package goxcel
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+// WriteFile writes workbook as an XLSX archive to path, storing each string
+// directly in its cell as a t="str" value (no shared-strings table and no
+// t="inlineStr" cells) so the output stays simple to produce
+// and round-trips cleanly with ReadFile. It's primarily intended for
+// persisting workbooks repaired by ValidateAndFix.
+func WriteFile(path string, workbook *Workbook) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("goxcel: create %q: %w", path, err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+
+	entries := []struct {
+		name string
+		data string
+	}{
+		{"[Content_Types].xml", contentTypesXML(workbook)},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", workbookXML(workbook)},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML(workbook)},
+	}
+	for i, sheet := range workbook.Sheets {
+		entries = append(entries, struct {
+			name string
+			data string
+		}{
+			name: fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1),
+			data: sheetXML(sheet),
+		})
+	}
+
+	for _, e := range entries {
+		w, err := zw.Create(e.name)
+		if err != nil {
+			return fmt.Errorf("goxcel: write %q: %w", path, err)
+		}
+		if _, err := w.Write([]byte(e.data)); err != nil {
+			return fmt.Errorf("goxcel: write %q: %w", path, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("goxcel: write %q: %w", path, err)
+	}
+	return nil
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const rootRelsXML = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func contentTypesXML(workbook *Workbook) string {
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	for i := range workbook.Sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func workbookXML(workbook *Workbook) string {
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`)
+	b.WriteString(`<sheets>`)
+	for i, sheet := range workbook.Sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(sheet.Name), i+1, i+1)
+	}
+	b.WriteString(`</sheets>`)
+	b.WriteString(`</workbook>`)
+	return b.String()
+}
+
+func workbookRelsXML(workbook *Workbook) string {
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range workbook.Sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+func sheetXML(sheet Sheet) string {
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	rowNum := 1
+	for tableIdx, table := range sheet.Tables {
+		if tableIdx > 0 {
+			fmt.Fprintf(&b, `<row r="%d"/>`, rowNum)
+			rowNum++
+		}
+		writeRowXML(&b, rowNum, cellsFromStrings(table.Headers))
+		rowNum++
+		for _, row := range table.Rows {
+			writeRowXML(&b, rowNum, row)
+			rowNum++
+		}
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func cellsFromStrings(headers []string) []Cell {
+	cells := make([]Cell, len(headers))
+	for i, h := range headers {
+		cells[i] = Cell{Value: h, Type: CellTypeString}
+	}
+	return cells
+}
+
+func writeRowXML(b *bytes.Buffer, rowNum int, cells []Cell) {
+	fmt.Fprintf(b, `<row r="%d">`, rowNum)
+	for i, cell := range cells {
+		ref := a1Ref(i, rowNum-1) // dataRowIdx = rowNum-1 so header row (rowNum 1) maps to row 1
+		writeCellXML(b, ref, cell)
+	}
+	b.WriteString(`</row>`)
+}
+
+func writeCellXML(b *bytes.Buffer, ref string, cell Cell) {
+	switch cell.Type {
+	case CellTypeEmpty:
+		if cell.Value == "" {
+			fmt.Fprintf(b, `<c r="%s"/>`, ref)
+			return
+		}
+		fmt.Fprintf(b, `<c r="%s" t="str"><v>%s</v></c>`, ref, escapeXML(cell.Value))
+	case CellTypeNumber:
+		fmt.Fprintf(b, `<c r="%s"><v>%s</v></c>`, ref, escapeXML(cell.Value))
+	case CellTypeBool:
+		fmt.Fprintf(b, `<c r="%s" t="b"><v>%s</v></c>`, ref, escapeXML(cell.Value))
+	default: // CellTypeString, CellTypeDate
+		fmt.Fprintf(b, `<c r="%s" t="str"><v>%s</v></c>`, ref, escapeXML(cell.Value))
+	}
+}
+
+func escapeXML(s string) string {
+	var b bytes.Buffer
+	if err := xml.EscapeText(&b, []byte(s)); err != nil {
+		return s
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package goxcel
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileRoundTrip(t *testing.T) {
+	wb := &Workbook{
+		Sheets: []Sheet{
+			{
+				Name: "Orders",
+				Tables: []Table{
+					{
+						Headers: []string{"ID", "Quantity", "Status"},
+						Rows: [][]Cell{
+							{{Value: "1", Type: CellTypeNumber}, {Value: "5", Type: CellTypeNumber}, {Value: "ok", Type: CellTypeString}},
+							{{Value: "2", Type: CellTypeNumber}, {Value: "7", Type: CellTypeNumber}, {Value: "", Type: CellTypeEmpty}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "out.xlsx")
+	if err := WriteFile(path, wb); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	if len(got.Sheets) != 1 || got.Sheets[0].Name != "Orders" {
+		t.Fatalf("unexpected sheets: %+v", got.Sheets)
+	}
+	table := got.Sheets[0].Tables[0]
+	wantHeaders := []string{"ID", "Quantity", "Status"}
+	for i, h := range wantHeaders {
+		if table.Headers[i] != h {
+			t.Errorf("headers[%d] = %q, want %q", i, table.Headers[i], h)
+		}
+	}
+	if len(table.Rows) != 2 {
+		t.Fatalf("got %d data rows, want 2", len(table.Rows))
+	}
+	if table.Rows[0][2].Value != "ok" || table.Rows[0][2].Type != CellTypeString {
+		t.Errorf("row0 Status = %+v, want {ok string}", table.Rows[0][2])
+	}
+	if table.Rows[1][1].Value != "7" || table.Rows[1][1].Type != CellTypeNumber {
+		t.Errorf("row1 Quantity = %+v, want {7 number}", table.Rows[1][1])
+	}
+}